@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	authutils "github.com/forbole/bdjuno/v4/modules/auth"
+)
+
+const (
+	flagBatchSize = "batch-size"
+)
+
+// activityCmd returns a Cobra command that allows to index the accounts activity of the stored messages
+func activityCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activity",
+		Short: "Index the accounts activity and the fees they paid using the messages and transactions already stored",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			batchSize, err := cmd.Flags().GetInt64(flagBatchSize)
+			if err != nil {
+				return err
+			}
+
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid batch size: %d", batchSize)
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the auth module
+			authModule := authutils.NewModule(config.Cfg, nil, nil, parseCtx.EncodingConfig.Codec, db)
+
+			block, err := db.GetLastBlockHeightAndTimestamp()
+			if err != nil {
+				return fmt.Errorf("error while getting latest block height: %s", err)
+			}
+
+			return authModule.BackfillAccountsActivity(1, block.Height, batchSize)
+		},
+	}
+
+	cmd.Flags().Int64(flagBatchSize, 10000, "Number of blocks to process at a time")
+
+	return cmd
+}
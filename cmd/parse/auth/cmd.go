@@ -14,6 +14,8 @@ func NewAuthCmd(parseCfg *parsecmdtypes.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		vestingCmd(parseCfg),
+		moduleAccountsCmd(parseCfg),
+		activityCmd(parseCfg),
 	)
 
 	return cmd
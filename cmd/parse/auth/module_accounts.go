@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	authutils "github.com/forbole/bdjuno/v4/modules/auth"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+// moduleAccountsCmd returns a Cobra command that allows to refresh the module accounts and the account labels
+func moduleAccountsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-accounts",
+		Short: "Refresh the module accounts at the latest height and reload the configured account labels",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the auth module
+			authModule := authutils.NewModule(config.Cfg, sources.AuthSource, nil, parseCtx.EncodingConfig.Codec, db)
+
+			height, err := parseCtx.Node.LatestHeight()
+			if err != nil {
+				return fmt.Errorf("error while getting latest height: %s", err)
+			}
+
+			err = authModule.RefreshModuleAccounts(height)
+			if err != nil {
+				return fmt.Errorf("error while refreshing module accounts: %s", err)
+			}
+
+			return authModule.LoadLabels()
+		},
+	}
+}
@@ -10,14 +10,21 @@ import (
 
 	"github.com/forbole/bdjuno/v4/database"
 	authutils "github.com/forbole/bdjuno/v4/modules/auth"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/utils"
 )
 
+const (
+	flagAddress = "address"
+)
+
 // vestingCmd returns a Cobra command that allows to fix the vesting data for the accounts
 func vestingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
-	return &cobra.Command{
-		Use:   "vesting-accounts",
-		Short: "Fix the vesting accounts stored by removing duplicated vesting periods",
+	cmd := &cobra.Command{
+		Use:     "vesting",
+		Aliases: []string{"vesting-accounts"},
+		Short: "Fix the vesting accounts stored by re-importing the genesis ones, " +
+			"or by refreshing the given accounts from the chain at the latest height",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
 			if err != nil {
@@ -27,6 +34,35 @@ func vestingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 			// Get the database
 			db := database.Cast(parseCtx.Database)
 
+			addresses, err := cmd.Flags().GetStringSlice(flagAddress)
+			if err != nil {
+				return err
+			}
+
+			if len(addresses) > 0 {
+				sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+				if err != nil {
+					return err
+				}
+
+				// Build the auth module
+				authModule := authutils.NewModule(config.Cfg, sources.AuthSource, nil, parseCtx.EncodingConfig.Codec, db)
+
+				height, err := parseCtx.Node.LatestHeight()
+				if err != nil {
+					return fmt.Errorf("error while getting latest height: %s", err)
+				}
+
+				for _, address := range addresses {
+					err = authModule.RefreshVestingAccount(address, height)
+					if err != nil {
+						return fmt.Errorf("error while refreshing vesting account: %s", err)
+					}
+				}
+
+				return nil
+			}
+
 			// Get the genesis
 			genesis, err := utils.ReadGenesis(config.Cfg, parseCtx.Node)
 			if err != nil {
@@ -51,4 +87,8 @@ func vestingCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().StringSlice(flagAddress, nil, "Addresses of the vesting accounts to be refreshed from the chain")
+
+	return cmd
 }
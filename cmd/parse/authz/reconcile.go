@@ -0,0 +1,83 @@
+package authz
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/authz"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+const (
+	flagGranter = "granter"
+)
+
+// reconcileCmd returns the Cobra command allowing to reconcile the stored grants with the ones existing on chain
+func reconcileCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Compare the stored grants with the ones existing on chain at the latest height and fix them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build authz module
+			authzModule := authz.NewModule(sources.AuthzSource, parseCtx.EncodingConfig.Codec, db)
+
+			// Mark the expired grants first, so that they are not removed
+			err = authzModule.SweepExpiredGrants()
+			if err != nil {
+				return fmt.Errorf("error while marking expired grants: %s", err)
+			}
+
+			block, err := db.GetLastBlockHeightAndTimestamp()
+			if err != nil {
+				return fmt.Errorf("error while getting latest block height: %s", err)
+			}
+
+			granters, err := cmd.Flags().GetStringSlice(flagGranter)
+			if err != nil {
+				return err
+			}
+
+			if len(granters) == 0 {
+				granters, err = db.GetAuthzGranters()
+				if err != nil {
+					return fmt.Errorf("error while getting authz granters: %s", err)
+				}
+			}
+
+			total := 0
+			for _, granter := range granters {
+				fixed, err := authzModule.ReconcileGranterGrants(granter, block.Height, block.BlockTimestamp)
+				if err != nil {
+					return fmt.Errorf("error while reconciling grants of %s: %s", granter, err)
+				}
+				total += fixed
+			}
+
+			log.Info().Int64("height", block.Height).Int("granters", len(granters)).Int("fixed", total).
+				Msg("reconciled authz grants")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagGranter, nil, "Granters whose grants should be reconciled (default all the stored ones)")
+
+	return cmd
+}
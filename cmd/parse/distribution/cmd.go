@@ -14,6 +14,7 @@ func NewDistributionCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		communityPoolCmd(parseConfig),
+		rewardsCmd(parseConfig),
 	)
 
 	return cmd
@@ -32,7 +32,7 @@ func communityPoolCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 			db := database.Cast(parseCtx.Database)
 
 			// Build distribution module
-			distrModule := distribution.NewModule(sources.DistrSource, parseCtx.EncodingConfig.Codec, db)
+			distrModule := distribution.NewModule(config.Cfg, sources.DistrSource, parseCtx.EncodingConfig.Codec, db)
 
 			err = distrModule.GetLatestCommunityPool()
 			if err != nil {
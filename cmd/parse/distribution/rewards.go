@@ -0,0 +1,103 @@
+package distribution
+
+import (
+	"fmt"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/distribution"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+const (
+	flagAddress = "address"
+	flagFrom    = "from"
+	flagTo      = "to"
+)
+
+// rewardsCmd returns the Cobra command allowing to backfill the delegators pending rewards snapshots
+func rewardsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Snapshot the pending rewards of the given delegators at the first block after the configured time of each day between the given heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addresses, err := cmd.Flags().GetStringSlice(flagAddress)
+			if err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build distribution module
+			distrModule := distribution.NewModule(config.Cfg, sources.DistrSource, parseCtx.EncodingConfig.Codec, db)
+
+			// Use the configured watch list when no address is given
+			if len(addresses) == 0 {
+				addresses = distrModule.WatchedDelegators()
+			}
+
+			if to == 0 {
+				block, err := db.GetLastBlockHeightAndTimestamp()
+				if err != nil {
+					return fmt.Errorf("error while getting latest block height: %s", err)
+				}
+				to = block.Height
+			}
+
+			// Take the snapshots at the same time of the day as the periodic operation does
+			timeOfDay, err := distrModule.RewardsSnapshotTimeOfDay()
+			if err != nil {
+				return err
+			}
+
+			blocks, err := db.GetFirstBlocksOfDays(from, to, timeOfDay)
+			if err != nil {
+				return err
+			}
+
+			for _, block := range blocks {
+				for _, address := range addresses {
+					log.Info().Str("delegator", address).Int64("height", block.Height).Msg("snapshotting rewards")
+
+					err = distrModule.SnapshotDelegatorRewards(address, block.Height, block.BlockTimestamp)
+					if err != nil {
+						return fmt.Errorf("error while snapshotting rewards of %s at height %d: %s", address, block.Height, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAddress, nil, "Delegators to snapshot (can be repeated or comma separated, defaults to the configured watch list)")
+	cmd.Flags().Int64(flagFrom, 1, "Height from which to start the snapshots")
+	cmd.Flags().Int64(flagTo, 0, "Height at which to stop the snapshots (defaults to the latest stored height)")
+
+	return cmd
+}
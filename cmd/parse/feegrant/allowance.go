@@ -9,6 +9,7 @@ import (
 	"github.com/forbole/juno/v5/types/config"
 
 	"github.com/forbole/bdjuno/v4/modules/feegrant"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/utils"
 
 	"github.com/spf13/cobra"
@@ -32,11 +33,16 @@ func allowanceCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 				return err
 			}
 
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
 			// Get the database
 			db := database.Cast(parseCtx.Database)
 
 			// Build feegrant module
-			feegrantModule := feegrant.NewModule(parseCtx.EncodingConfig.Codec, db)
+			feegrantModule := feegrant.NewModule(sources.FeegrantSource, parseCtx.EncodingConfig.Codec, db)
 
 			// Get the accounts
 			// Collect all the transactions
@@ -14,6 +14,7 @@ func NewFeegrantCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		allowanceCmd(parseConfig),
+		reconcileCmd(parseConfig),
 	)
 
 	return cmd
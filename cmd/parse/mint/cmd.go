@@ -13,6 +13,7 @@ func NewMintCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		annualProvisionsCmd(parseConfig),
 		inflationCmd(parseConfig),
 	)
 
@@ -0,0 +1,107 @@
+package params
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/auth"
+	"github.com/forbole/bdjuno/v4/modules/bank"
+	"github.com/forbole/bdjuno/v4/modules/consensus"
+	"github.com/forbole/bdjuno/v4/modules/distribution"
+	"github.com/forbole/bdjuno/v4/modules/gov"
+	"github.com/forbole/bdjuno/v4/modules/mint"
+	"github.com/forbole/bdjuno/v4/modules/slashing"
+	"github.com/forbole/bdjuno/v4/modules/staking"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+const (
+	flagHeight = "height"
+)
+
+// NewParamsCmd returns the Cobra command allowing to refresh the params of all the modules,
+// storing them inside the params history
+func NewParamsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Refresh the params of all the modules at the given heights (the latest one by default)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			heights, err := cmd.Flags().GetInt64Slice(flagHeight)
+			if err != nil {
+				return err
+			}
+
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			sources, err := modulestypes.BuildSources(config.Cfg.Node, parseCtx.EncodingConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the modules
+			paramsRegistry, _ := BuildParamsRegistry(sources, parseCtx.EncodingConfig.Codec, db)
+
+			if len(heights) == 0 {
+				height, err := parseCtx.Node.LatestHeight()
+				if err != nil {
+					return fmt.Errorf("error while getting chain latest block height: %s", err)
+				}
+				heights = []int64{height}
+			}
+
+			for _, height := range heights {
+				for _, registration := range paramsRegistry.Registrations() {
+					log.Info().Str("module", registration.Module).Int64("height", height).Msg("refreshing params")
+
+					err = registration.Refresh(height)
+					if err != nil {
+						return fmt.Errorf("error while updating %s params at height %d: %s", registration.Module, height, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Slice(flagHeight, nil, "Heights at which to refresh the params (can be repeated or comma separated)")
+
+	return cmd
+}
+
+// BuildParamsRegistry builds all the modules owning some params, and returns the registry containing them
+// along with the gov module that uses it
+func BuildParamsRegistry(
+	sources *modulestypes.Sources, cdc codec.Codec, db *database.Db,
+) (*modulestypes.ParamsRegistry, *gov.Module) {
+	paramsRegistry := modulestypes.NewParamsRegistry()
+
+	distrModule := distribution.NewModule(config.Cfg, sources.DistrSource, cdc, db)
+	stakingModule := staking.NewModule(sources.StakingSource, cdc, db)
+	govModule := gov.NewModule(sources.GovSource, paramsRegistry, distrModule, stakingModule, cdc, db)
+
+	paramsRegistry.Register(
+		auth.NewModule(config.Cfg, sources.AuthSource, nil, cdc, db),
+		bank.NewModule(nil, sources.BankSource, cdc, db),
+		consensus.NewModule(sources.ConsensusSource, db),
+		distrModule,
+		govModule,
+		mint.NewModule(sources.MintSource, cdc, db),
+		slashing.NewModule(sources.SlashingSource, cdc, db),
+		stakingModule,
+	)
+
+	return paramsRegistry, govModule
+}
@@ -11,13 +11,16 @@ import (
 	parsetransaction "github.com/forbole/juno/v5/cmd/parse/transactions"
 
 	parseauth "github.com/forbole/bdjuno/v4/cmd/parse/auth"
+	parseauthz "github.com/forbole/bdjuno/v4/cmd/parse/authz"
 	parsebank "github.com/forbole/bdjuno/v4/cmd/parse/bank"
 	parsedistribution "github.com/forbole/bdjuno/v4/cmd/parse/distribution"
 	parsefeegrant "github.com/forbole/bdjuno/v4/cmd/parse/feegrant"
 	parsegov "github.com/forbole/bdjuno/v4/cmd/parse/gov"
 	parsemint "github.com/forbole/bdjuno/v4/cmd/parse/mint"
+	parseparams "github.com/forbole/bdjuno/v4/cmd/parse/params"
 	parsepricefeed "github.com/forbole/bdjuno/v4/cmd/parse/pricefeed"
 	parsestaking "github.com/forbole/bdjuno/v4/cmd/parse/staking"
+	parsestats "github.com/forbole/bdjuno/v4/cmd/parse/stats"
 )
 
 // NewParseCmd returns the Cobra command allowing to parse some chain data without having to re-sync the whole database
@@ -30,6 +33,7 @@ func NewParseCmd(parseCfg *parse.Config) *cobra.Command {
 
 	cmd.AddCommand(
 		parseauth.NewAuthCmd(parseCfg),
+		parseauthz.NewAuthzCmd(parseCfg),
 		parsebank.NewBankCmd(parseCfg),
 		parseblocks.NewBlocksCmd(parseCfg),
 		parsedistribution.NewDistributionCmd(parseCfg),
@@ -37,8 +41,10 @@ func NewParseCmd(parseCfg *parse.Config) *cobra.Command {
 		parsegenesis.NewGenesisCmd(parseCfg),
 		parsegov.NewGovCmd(parseCfg),
 		parsemint.NewMintCmd(parseCfg),
+		parseparams.NewParamsCmd(parseCfg),
 		parsepricefeed.NewPricefeedCmd(parseCfg),
 		parsestaking.NewStakingCmd(parseCfg),
+		parsestats.NewStatsCmd(parseCfg),
 		parsetransaction.NewTransactionsCmd(parseCfg),
 	)
 
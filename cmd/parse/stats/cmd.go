@@ -0,0 +1,21 @@
+package stats
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/spf13/cobra"
+)
+
+// NewStatsCmd returns the Cobra command allowing to fix the chain stats
+func NewStatsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Fix things related to the chain stats rollups and the message types registry",
+	}
+
+	cmd.AddCommand(
+		rollupsCmd(parseConfig),
+		messageTypesCmd(parseConfig),
+	)
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package stats
+
+import (
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/stats"
+)
+
+// messageTypesCmd returns the Cobra command allowing to rebuild the message types registry
+func messageTypesCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "message-types",
+		Short: "Store the registered message types and compute again their usage using the messages already stored",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the stats module
+			statsModule := stats.NewModule(parseCtx.EncodingConfig.InterfaceRegistry, db)
+
+			return statsModule.RebuildMessageTypes()
+		},
+	}
+}
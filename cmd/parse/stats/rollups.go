@@ -0,0 +1,99 @@
+package stats
+
+import (
+	"fmt"
+	"time"
+
+	parsecmdtypes "github.com/forbole/juno/v5/cmd/parse/types"
+	"github.com/forbole/juno/v5/types/config"
+	"github.com/spf13/cobra"
+
+	"github.com/forbole/bdjuno/v4/database"
+	"github.com/forbole/bdjuno/v4/modules/stats"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
+
+	dateLayout = "2006-01-02"
+)
+
+// rollupsCmd returns the Cobra command allowing to rebuild the chain stats rollups for a range of dates
+func rollupsCmd(parseConfig *parsecmdtypes.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollups",
+		Short: "Rebuild the hourly and daily chain stats rollups of the days between the given dates (both included)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parseCtx, err := parsecmdtypes.GetParserContext(config.Cfg, parseConfig)
+			if err != nil {
+				return err
+			}
+
+			// Get the database
+			db := database.Cast(parseCtx.Database)
+
+			// Build the stats module
+			statsModule := stats.NewModule(parseCtx.EncodingConfig.InterfaceRegistry, db)
+
+			from, err := getDateFlag(cmd, flagFrom)
+			if err != nil {
+				return err
+			}
+
+			if from.IsZero() {
+				block, err := db.GetFirstBlockHeightAndTimestamp()
+				if err != nil {
+					return err
+				}
+				from = block.BlockTimestamp
+			}
+
+			to, err := getDateFlag(cmd, flagTo)
+			if err != nil {
+				return err
+			}
+
+			if to.IsZero() {
+				block, err := db.GetLastBlockHeightAndTimestamp()
+				if err != nil {
+					return err
+				}
+				to = block.BlockTimestamp
+			}
+
+			// Include the whole last day
+			to = types.StatsPeriodDay.Next(types.StatsPeriodDay.Truncate(to)).Add(-1)
+
+			for _, period := range []types.StatsPeriod{types.StatsPeriodHour, types.StatsPeriodDay} {
+				err = statsModule.RebuildRollups(period, from, to)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFrom, "", "Date (YYYY-MM-DD) of the first day to rebuild (defaults to the first stored block day)")
+	cmd.Flags().String(flagTo, "", "Date (YYYY-MM-DD) of the last day to rebuild (defaults to the latest stored block day)")
+
+	return cmd
+}
+
+// getDateFlag parses the date contained inside the flag having the given name, returning a zero time when not set
+func getDateFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s date: %s", flag, err)
+	}
+
+	return date, nil
+}
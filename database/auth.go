@@ -1,12 +1,18 @@
 package database
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
@@ -59,6 +65,277 @@ func (db *Db) saveAccounts(paramsNumber int, accounts []types.Account) error {
 	return nil
 }
 
+// SaveAccountsDetails saves the given accounts details inside the database
+func (db *Db) SaveAccountsDetails(accounts []types.AccountDetails) error {
+	paramsNumber := 8
+	maxAccountsPerSlice := 65535 / paramsNumber
+
+	for start := 0; start < len(accounts); start += maxAccountsPerSlice {
+		end := start + maxAccountsPerSlice
+		if end > len(accounts) {
+			end = len(accounts)
+		}
+
+		err := db.saveAccountsDetails(paramsNumber, accounts[start:end])
+		if err != nil {
+			return fmt.Errorf("error while storing accounts details: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveAccountsDetails(paramsNumber int, accounts []types.AccountDetails) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO account (address, type, pubkey_type, pubkey, account_number, sequence, first_seen_height, height) 
+VALUES `
+	var params []interface{}
+
+	for i, account := range accounts {
+		ai := i * paramsNumber
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		var pubKeyType sql.NullString
+		var pubKeyJSON []byte
+		if account.PubKey != nil {
+			bz, err := codec.ProtoMarshalJSON(account.PubKey, nil)
+			if err != nil {
+				return fmt.Errorf("error while marshaling public key of %s: %s", account.Address, err)
+			}
+			pubKeyType = sql.NullString{String: proto.MessageName(account.PubKey), Valid: true}
+			pubKeyJSON = bz
+		}
+
+		params = append(params,
+			account.Address, account.Type, pubKeyType, pubKeyJSON,
+			account.AccountNumber, account.Sequence, account.Height, account.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+    SET type = excluded.type,
+        pubkey_type = COALESCE(excluded.pubkey_type, account.pubkey_type),
+        pubkey = COALESCE(excluded.pubkey, account.pubkey),
+        account_number = COALESCE(excluded.account_number, account.account_number),
+        sequence = COALESCE(excluded.sequence, account.sequence),
+        height = excluded.height
+WHERE account.height IS NULL OR account.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing accounts details: %s", err)
+	}
+
+	// The details above are updated only with newer data, while the first seen height can be lowered
+	// when parsing older heights, so it needs to be updated separately
+	addresses := make([]string, len(accounts))
+	heights := make([]int64, len(accounts))
+	for i, account := range accounts {
+		addresses[i], heights[i] = account.Address, account.Height
+	}
+
+	stmt = `
+UPDATE account SET first_seen_height = seen.height
+FROM (
+    SELECT address, MIN(height) AS height
+    FROM unnest($1::TEXT[], $2::BIGINT[]) AS seen(address, height)
+    GROUP BY address
+) AS seen
+WHERE account.address = seen.address 
+  AND (account.first_seen_height IS NULL OR account.first_seen_height > seen.height)`
+
+	_, err = db.SQL.Exec(stmt, pq.Array(addresses), pq.Array(heights))
+	if err != nil {
+		return fmt.Errorf("error while updating accounts first seen height: %s", err)
+	}
+
+	return nil
+}
+
+// SaveModuleAccounts saves the given module accounts inside the database
+func (db *Db) SaveModuleAccounts(accounts []types.ModuleAccount) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	var addresses []types.Account
+	for _, account := range accounts {
+		addresses = append(addresses, types.NewAccount(account.Address))
+	}
+
+	err := db.SaveAccounts(addresses)
+	if err != nil {
+		return fmt.Errorf("error while storing module accounts addresses: %s", err)
+	}
+
+	stmt := `INSERT INTO module_account (address, name, permissions, height) VALUES `
+	var params []interface{}
+
+	for i, account := range accounts {
+		ai := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params, account.Address, account.Name, pq.Array(account.Permissions), account.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (address) DO UPDATE 
+    SET name = excluded.name,
+        permissions = excluded.permissions,
+        height = excluded.height
+WHERE module_account.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing module accounts: %s", err)
+	}
+
+	return nil
+}
+
+// SaveAccountLabels replaces all the stored account labels with the given ones.
+// Everything is done within a single database transaction so that readers never see the labels partially replaced
+func (db *Db) SaveAccountLabels(labels []types.AccountLabel) error {
+	var addresses []types.Account
+	for _, label := range labels {
+		addresses = append(addresses, types.NewAccount(label.Address))
+	}
+
+	err := db.SaveAccounts(addresses)
+	if err != nil {
+		return fmt.Errorf("error while storing labeled accounts: %s", err)
+	}
+
+	tx, err := db.Sqlx.Beginx()
+	if err != nil {
+		return fmt.Errorf("error while beginning account labels transaction: %s", err)
+	}
+
+	_, err = tx.Exec(`DELETE FROM account_label`)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while deleting account labels: %s", err)
+	}
+
+	if len(labels) > 0 {
+		stmt := `INSERT INTO account_label (address, label, category) VALUES `
+		var params []interface{}
+
+		for i, label := range labels {
+			ai := i * 3
+			stmt += fmt.Sprintf("($%d,$%d,$%d),", ai+1, ai+2, ai+3)
+			params = append(params, label.Address, label.Label, dbtypes.ToNullString(label.Category))
+		}
+
+		stmt = stmt[:len(stmt)-1] // Remove trailing ","
+		stmt += ` ON CONFLICT (address) DO UPDATE SET label = excluded.label, category = excluded.category`
+
+		_, err = tx.Exec(stmt, params...)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("error while storing account labels: %s", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error while committing account labels transaction: %s", err)
+	}
+
+	return nil
+}
+
+// SaveMultisigAccount saves the given multisig account along with its members inside the database
+func (db *Db) SaveMultisigAccount(account types.MultisigAccount) error {
+	addresses := []types.Account{types.NewAccount(account.Address)}
+	for _, member := range account.Members {
+		addresses = append(addresses, types.NewAccount(sdk.AccAddress(member.Address()).String()))
+	}
+
+	err := db.SaveAccounts(addresses)
+	if err != nil {
+		return fmt.Errorf("error while storing multisig accounts addresses: %s", err)
+	}
+
+	// The members of a multisig account never change, since its address is derived from them
+	stmt := `
+INSERT INTO multisig_account (address, threshold, height) VALUES ($1, $2, $3) 
+ON CONFLICT (address) DO NOTHING`
+	_, err = db.SQL.Exec(stmt, account.Address, account.Threshold, account.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing multisig account: %s", err)
+	}
+
+	if len(account.Members) == 0 {
+		return nil
+	}
+
+	stmt = `INSERT INTO multisig_account_member (multisig_address, member_index, member_address, pubkey_type, pubkey) VALUES `
+	var params []interface{}
+
+	for i, member := range account.Members {
+		pubKeyJSON, err := codec.ProtoMarshalJSON(member, nil)
+		if err != nil {
+			return fmt.Errorf("error while marshaling multisig member public key: %s", err)
+		}
+
+		mi := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", mi+1, mi+2, mi+3, mi+4, mi+5)
+		params = append(params,
+			account.Address, i, sdk.AccAddress(member.Address()).String(), proto.MessageName(member), pubKeyJSON)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT DO NOTHING"
+
+	_, err = db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing multisig account members: %s", err)
+	}
+
+	return nil
+}
+
+// GetMultisigAccountMembers returns the addresses of the members of the given multisig account, sorted by their index.
+// It returns an empty slice if the account is not stored
+func (db *Db) GetMultisigAccountMembers(address string) ([]string, error) {
+	var members []string
+	err := db.Sqlx.Select(&members,
+		`SELECT member_address FROM multisig_account_member WHERE multisig_address = $1 ORDER BY member_index`, address)
+	return members, err
+}
+
+// SaveMultisigSigners saves the given multisig signers inside the database
+func (db *Db) SaveMultisigSigners(signers []types.MultisigSigner) error {
+	if len(signers) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO multisig_signer (transaction_hash, multisig_address, member_address, height) VALUES `
+	var params []interface{}
+
+	for i, signer := range signers {
+		si := i * 4
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", si+1, si+2, si+3, si+4)
+		params = append(params, signer.TxHash, signer.MultisigAddress, signer.MemberAddress, signer.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT DO NOTHING"
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing multisig signers: %s", err)
+	}
+
+	return nil
+}
+
 // SaveVestingAccounts saves the given vesting accounts inside the database
 func (db *Db) SaveVestingAccounts(vestingAccounts []exported.VestingAccount) error {
 	if len(vestingAccounts) == 0 {
@@ -67,8 +344,16 @@ func (db *Db) SaveVestingAccounts(vestingAccounts []exported.VestingAccount) err
 
 	for _, account := range vestingAccounts {
 		switch vestingAccount := account.(type) {
-		case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount:
-			_, err := db.storeVestingAccount(account)
+		case *vestingtypes.ContinuousVestingAccount,
+			*vestingtypes.DelayedVestingAccount,
+			*vestingtypes.PermanentLockedAccount:
+			vestingAccountRowID, err := db.storeVestingAccount(account)
+			if err != nil {
+				return err
+			}
+
+			// Remove the periods the account might have had before being overridden
+			err = db.storeVestingPeriods(vestingAccountRowID, nil)
 			if err != nil {
 				return err
 			}
@@ -93,7 +378,8 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 	INSERT INTO vesting_account (type, address, original_vesting, end_time, start_time) 
 	VALUES ($1, $2, $3, $4, $5)
 	ON CONFLICT (address) DO UPDATE 
-		SET original_vesting = excluded.original_vesting, 
+		SET type = excluded.type,
+			original_vesting = excluded.original_vesting, 
 			end_time = excluded.end_time, 
 			start_time = excluded.start_time
 			RETURNING id `
@@ -109,8 +395,8 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 		proto.MessageName(account),
 		account.GetAddress().String(),
 		pq.Array(dbtypes.NewDbCoins(account.GetOriginalVesting())),
-		time.Unix(account.GetEndTime(), 0),
-		time.Unix(account.GetStartTime(), 0),
+		unixToNullTime(account.GetEndTime()),
+		unixToNullTime(account.GetStartTime()),
 	).Scan(&vestingAccountRowID)
 
 	if err != nil {
@@ -120,32 +406,12 @@ func (db *Db) storeVestingAccount(account exported.VestingAccount) (int, error)
 	return vestingAccountRowID, nil
 }
 
-func (db *Db) StoreBaseVestingAccountFromMsg(bva *vestingtypes.BaseVestingAccount, txTimestamp time.Time) error {
-	stmt := `
-	INSERT INTO vesting_account (type, address, original_vesting, start_time, end_time) 
-	VALUES ($1, $2, $3, $4, $5)
-	ON CONFLICT (address) DO UPDATE 
-		SET type = excluded.type,
-			original_vesting = excluded.original_vesting, 
-			start_time = excluded.start_time, 
-			end_time = excluded.end_time`
-
-	// Store the vesting account
-	err := db.SaveAccounts([]types.Account{types.NewAccount(bva.GetAddress().String())})
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account address: %s", err)
-	}
-
-	_, err = db.SQL.Exec(stmt,
-		proto.MessageName(bva),
-		bva.GetAddress().String(),
-		pq.Array(dbtypes.NewDbCoins(bva.OriginalVesting)),
-		txTimestamp,
-		time.Unix(bva.EndTime, 0))
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account: %s", err)
+// unixToNullTime converts the given unix timestamp to a sql.NullTime, considering 0 as a missing value
+func unixToNullTime(timestamp int64) sql.NullTime {
+	if timestamp == 0 {
+		return sql.NullTime{}
 	}
-	return nil
+	return sql.NullTime{Time: time.Unix(timestamp, 0), Valid: true}
 }
 
 // storeVestingPeriods handles storing the vesting periods of PeriodicVestingAccount type
@@ -157,6 +423,10 @@ func (db *Db) storeVestingPeriods(id int, vestingPeriods []vestingtypes.Period)
 		return fmt.Errorf("error while deleting vesting period: %s", err)
 	}
 
+	if len(vestingPeriods) == 0 {
+		return nil
+	}
+
 	// Store the new periods
 	stmt = `
 INSERT INTO vesting_period (vesting_account_id, period_order, length, amount) 
@@ -181,9 +451,130 @@ VALUES `
 	return nil
 }
 
+// GetVestingAccount returns the vesting account having the given address, or nil if it is not stored
+func (db *Db) GetVestingAccount(address string) (*dbtypes.VestingAccountRow, error) {
+	var rows []dbtypes.VestingAccountRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM vesting_account WHERE address = $1`, address)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting account: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// GetVestingAccounts returns all the vesting accounts stored inside the database
+func (db *Db) GetVestingAccounts() ([]dbtypes.VestingAccountRow, error) {
+	var rows []dbtypes.VestingAccountRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM vesting_account ORDER BY id`)
+	return rows, err
+}
+
+// GetVestingPeriods returns the vesting periods of the vesting account having the given id, sorted by their order
+func (db *Db) GetVestingPeriods(vestingAccountID int64) ([]dbtypes.VestingPeriodRow, error) {
+	var rows []dbtypes.VestingPeriodRow
+	err := db.Sqlx.Select(&rows,
+		`SELECT * FROM vesting_period WHERE vesting_account_id = $1 ORDER BY period_order`, vestingAccountID)
+	return rows, err
+}
+
+// GetAllVestingPeriods returns the vesting periods of all the stored vesting accounts, sorted by their order
+func (db *Db) GetAllVestingPeriods() ([]dbtypes.VestingPeriodRow, error) {
+	var rows []dbtypes.VestingPeriodRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM vesting_period ORDER BY vesting_account_id, period_order`)
+	return rows, err
+}
+
+// SaveVestingUnlockCalendar replaces the stored unlock calendar with the given one.
+// Everything is done within a single database transaction so that readers never see the calendar partially replaced
+func (db *Db) SaveVestingUnlockCalendar(unlocks []types.VestingUnlock) error {
+	tx, err := db.Sqlx.Beginx()
+	if err != nil {
+		return fmt.Errorf("error while beginning vesting unlock calendar transaction: %s", err)
+	}
+
+	_, err = tx.Exec(`DELETE FROM vesting_unlock_calendar`)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while deleting vesting unlock calendar: %s", err)
+	}
+
+	var params []interface{}
+	for _, unlock := range unlocks {
+		for _, coin := range unlock.Amount {
+			params = append(params, unlock.Date, coin.Denom, coin.Amount.String())
+		}
+	}
+
+	// Split the values so that each statement has at most 999 rows
+	paramsNumber := 3
+	maxParams := paramsNumber * 999
+	for start := 0; start < len(params); start += maxParams {
+		end := start + maxParams
+		if end > len(params) {
+			end = len(params)
+		}
+
+		err = saveVestingUnlockCalendar(tx, paramsNumber, params[start:end])
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error while committing vesting unlock calendar transaction: %s", err)
+	}
+
+	return nil
+}
+
+func saveVestingUnlockCalendar(tx *sqlx.Tx, paramsNumber int, params []interface{}) error {
+	stmt := `INSERT INTO vesting_unlock_calendar (date, denom, amount) VALUES `
+	for i := 0; i < len(params)/paramsNumber; i++ {
+		vi := i * paramsNumber
+		stmt += fmt.Sprintf("($%d,$%d,$%d),", vi+1, vi+2, vi+3)
+	}
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+
+	_, err := tx.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing vesting unlock calendar: %s", err)
+	}
+
+	return nil
+}
+
 // GetAccounts returns all the accounts that are currently stored inside the database.
 func (db *Db) GetAccounts() ([]string, error) {
 	var rows []string
 	err := db.Sqlx.Select(&rows, `SELECT address FROM account`)
 	return rows, err
 }
+
+// SaveAuthParams saves the given x/auth params inside the database
+func (db *Db) SaveAuthParams(params types.AuthParams) error {
+	paramsBz, err := json.Marshal(&params.Params)
+	if err != nil {
+		return fmt.Errorf("error while marshaling auth params: %s", err)
+	}
+
+	stmt := `
+INSERT INTO auth_params (params, height) 
+VALUES ($1, $2)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET params = excluded.params, 
+        height = excluded.height
+WHERE auth_params.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing auth params: %s", err)
+	}
+
+	return db.saveModuleParamsHistory(authtypes.ModuleName, paramsBz, params.Height)
+}
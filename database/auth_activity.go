@@ -0,0 +1,228 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/forbole/bdjuno/v4/types"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+)
+
+// withAccountActivityAggregates wraps the given account_message insertion statement so that the
+// aggregated accounts activity stats are updated using only the rows that have actually been inserted.
+// This allows to index the same message more than once without counting it twice.
+// NOTE: the insertion statement must use ON CONFLICT DO NOTHING and RETURNING all the account_message columns
+func withAccountActivityAggregates(insertStmt string) string {
+	return fmt.Sprintf(`
+WITH inserted AS (%s
+), activity AS (
+    INSERT INTO account_activity (address, first_height, first_time, last_height, last_time, message_count)
+    SELECT address, MIN(height), MIN(timestamp), MAX(height), MAX(timestamp), COUNT(*)
+    FROM inserted
+    GROUP BY address
+    ORDER BY address
+    ON CONFLICT (address) DO UPDATE 
+        SET first_height = LEAST(account_activity.first_height, excluded.first_height),
+            first_time = LEAST(account_activity.first_time, excluded.first_time),
+            last_height = GREATEST(account_activity.last_height, excluded.last_height),
+            last_time = GREATEST(account_activity.last_time, excluded.last_time),
+            message_count = account_activity.message_count + excluded.message_count
+)
+INSERT INTO account_message_count (address, type, count)
+SELECT address, type, COUNT(*)
+FROM inserted
+GROUP BY address, type
+ORDER BY address, type
+ON CONFLICT (address, type) DO UPDATE 
+    SET count = account_message_count.count + excluded.count`, insertStmt)
+}
+
+// SaveAccountsActivity stores the given activities and updates the stats of the accounts involved inside them.
+// Activities that have already been stored are ignored
+func (db *Db) SaveAccountsActivity(activities []types.AccountActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	// Sort the activities so that concurrent statements lock the account rows in the same order
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Address < activities[j].Address
+	})
+
+	var accounts []types.Account
+	for i, activity := range activities {
+		if i == 0 || activities[i-1].Address != activity.Address {
+			accounts = append(accounts, types.NewAccount(activity.Address))
+		}
+	}
+
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing active accounts: %s", err)
+	}
+
+	paramsNumber := 6
+	maxActivitiesPerSlice := 65535 / paramsNumber
+
+	for start := 0; start < len(activities); start += maxActivitiesPerSlice {
+		end := start + maxActivitiesPerSlice
+		if end > len(activities) {
+			end = len(activities)
+		}
+
+		err = db.saveAccountsActivity(paramsNumber, activities[start:end])
+		if err != nil {
+			return fmt.Errorf("error while storing accounts activity: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveAccountsActivity(paramsNumber int, activities []types.AccountActivity) error {
+	insertStmt := `
+    INSERT INTO account_message (address, transaction_hash, msg_index, type, height, timestamp) VALUES `
+	var params []interface{}
+
+	for i, activity := range activities {
+		ai := i * paramsNumber
+		insertStmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6)
+		params = append(params,
+			activity.Address, activity.TxHash, activity.MsgIndex, activity.MessageType, activity.Height, activity.Timestamp)
+	}
+
+	insertStmt = insertStmt[:len(insertStmt)-1] // Remove trailing ","
+	insertStmt += `
+    ON CONFLICT DO NOTHING
+    RETURNING address, transaction_hash, msg_index, type, height, timestamp`
+
+	_, err := db.SQL.Exec(withAccountActivityAggregates(insertStmt), params...)
+	return err
+}
+
+// feePaymentRow represents a single coin paid as fee by an account inside a transaction
+type feePaymentRow struct {
+	txHash  string
+	address string
+	denom   string
+	amount  string
+	height  int64
+}
+
+// SaveAccountsFeesPaid stores the given transaction fees and adds them to the ones already paid by the accounts.
+// Fees of transactions that have already been stored are ignored
+func (db *Db) SaveAccountsFeesPaid(fees []types.AccountFees) error {
+	var accounts []types.Account
+	var rows []feePaymentRow
+	seen := map[string]bool{}
+	for _, fee := range fees {
+		if fee.Amount.IsZero() {
+			continue
+		}
+
+		if !seen[fee.Address] {
+			seen[fee.Address] = true
+			accounts = append(accounts, types.NewAccount(fee.Address))
+		}
+
+		for _, coin := range fee.Amount {
+			rows = append(rows, feePaymentRow{
+				txHash:  fee.TxHash,
+				address: fee.Address,
+				denom:   coin.Denom,
+				amount:  coin.Amount.String(),
+				height:  fee.Height,
+			})
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing fee payers accounts: %s", err)
+	}
+
+	paramsNumber := 5
+	maxRowsPerSlice := 65535 / paramsNumber
+
+	for start := 0; start < len(rows); start += maxRowsPerSlice {
+		end := start + maxRowsPerSlice
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		err = db.saveAccountsFeesPaid(paramsNumber, rows[start:end])
+		if err != nil {
+			return fmt.Errorf("error while storing accounts fees paid: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Db) saveAccountsFeesPaid(paramsNumber int, rows []feePaymentRow) error {
+	stmt := `
+WITH inserted AS (
+    INSERT INTO account_fee_payment (transaction_hash, address, denom, amount, height) VALUES `
+	var params []interface{}
+
+	for i, row := range rows {
+		fi := i * paramsNumber
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", fi+1, fi+2, fi+3, fi+4, fi+5)
+		params = append(params, row.txHash, row.address, row.denom, row.amount, row.height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+    ON CONFLICT DO NOTHING
+    RETURNING address, denom, amount
+)
+INSERT INTO account_fees_paid (address, denom, amount)
+SELECT address, denom, SUM(amount)
+FROM inserted
+GROUP BY address, denom
+ORDER BY address, denom
+ON CONFLICT (address, denom) DO UPDATE 
+    SET amount = account_fees_paid.amount + excluded.amount`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	return err
+}
+
+// BackfillAccountsActivity stores the activity of the accounts involved inside the messages stored
+// between the given heights (both included) that have not been indexed yet, updating their stats accordingly.
+// Since already indexed messages are ignored, this can safely run while the chain is being parsed
+func (db *Db) BackfillAccountsActivity(fromHeight, toHeight int64) error {
+	// Only consider each address once per message, and ignore the ones that are not accounts (eg. validators)
+	insertStmt := `
+    INSERT INTO account_message (address, transaction_hash, msg_index, type, height, timestamp)
+    SELECT DISTINCT a.address, m.transaction_hash, m.index, m.type, m.height, b.timestamp
+    FROM message m
+        CROSS JOIN LATERAL unnest(m.involved_accounts_addresses) AS involved(address)
+        JOIN account a ON a.address = involved.address
+        JOIN block b ON b.height = m.height
+    WHERE m.height >= $1 AND m.height <= $2
+    ORDER BY a.address
+    ON CONFLICT DO NOTHING
+    RETURNING address, transaction_hash, msg_index, type, height, timestamp`
+
+	_, err := db.SQL.Exec(withAccountActivityAggregates(insertStmt), fromHeight, toHeight)
+	if err != nil {
+		return fmt.Errorf("error while backfilling accounts activity: %s", err)
+	}
+
+	return nil
+}
+
+// GetTransactionsFees returns the messages and fees of the transactions stored between the given heights (both included)
+func (db *Db) GetTransactionsFees(fromHeight, toHeight int64) ([]dbtypes.TransactionFeeRow, error) {
+	stmt := `SELECT hash, height, messages, fee FROM transaction WHERE height >= $1 AND height <= $2`
+
+	var rows []dbtypes.TransactionFeeRow
+	err := db.Sqlx.Select(&rows, stmt, fromHeight, toHeight)
+	return rows, err
+}
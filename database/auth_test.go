@@ -1,8 +1,16 @@
 package database_test
 
 import (
+	"encoding/json"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/forbole/bdjuno/v4/types"
 
@@ -31,7 +39,7 @@ func (suite *DbTestSuite) TestSaveAccount() {
 
 	// Accounts row
 	var accountRows []dbtypes.AccountRow
-	err = suite.database.Sqlx.Select(&accountRows, `SELECT * FROM account`)
+	err = suite.database.Sqlx.Select(&accountRows, `SELECT address FROM account`)
 	suite.Require().NoError(err)
 	suite.Require().Len(accountRows, 1, "account table should contain only one row")
 
@@ -39,6 +47,62 @@ func (suite *DbTestSuite) TestSaveAccount() {
 	suite.Require().True(expectedAccountRow.Equal(accountRows[0]))
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsDetails() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	address := sdk.AccAddress(pubKey.Address())
+
+	account := authttypes.NewBaseAccount(address, pubKey, 10, 5)
+	err := suite.database.SaveAccountsDetails([]types.AccountDetails{types.NewAccountDetails(account, 100)})
+	suite.Require().NoError(err)
+
+	// Update the sequence at a later height
+	account.Sequence = 6
+	err = suite.database.SaveAccountsDetails([]types.AccountDetails{types.NewAccountDetails(account, 200)})
+	suite.Require().NoError(err)
+
+	// Older data should be ignored, except for the first seen height
+	account.Sequence = 1
+	err = suite.database.SaveAccountsDetails([]types.AccountDetails{types.NewAccountDetails(account, 50)})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AccountDetailsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(address.String(), rows[0].Address)
+	suite.Require().Equal("cosmos.auth.v1beta1.BaseAccount", rows[0].Type.String)
+	suite.Require().Equal("cosmos.crypto.secp256k1.PubKey", rows[0].PubKeyType.String)
+	suite.Require().Equal(int64(10), rows[0].AccountNumber.Int64)
+	suite.Require().Equal(int64(6), rows[0].Sequence.Int64)
+	suite.Require().Equal(int64(50), rows[0].FirstSeenHeight.Int64)
+	suite.Require().Equal(int64(200), rows[0].Height.Int64)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsDetails_UnknownType() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	address := sdk.AccAddress(pubKey.Address())
+
+	account := authttypes.NewBaseAccount(address, pubKey, 10, 5)
+	err := suite.database.SaveAccountsDetails([]types.AccountDetails{types.NewAccountDetails(account, 100)})
+	suite.Require().NoError(err)
+
+	// Accounts that can't be decoded should only have their type updated
+	err = suite.database.SaveAccountsDetails([]types.AccountDetails{
+		types.NewUnknownAccountDetails(address.String(), "/ibc.applications.interchain_accounts.v1.InterchainAccount", 200),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AccountDetailsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("ibc.applications.interchain_accounts.v1.InterchainAccount", rows[0].Type.String)
+	suite.Require().Equal("cosmos.crypto.secp256k1.PubKey", rows[0].PubKeyType.String)
+	suite.Require().Equal(int64(10), rows[0].AccountNumber.Int64)
+	suite.Require().Equal(int64(5), rows[0].Sequence.Int64)
+	suite.Require().Equal(int64(200), rows[0].Height.Int64)
+}
+
 func (suite *DbTestSuite) TestBigDipperDb_GetAccounts() {
 	// Insert the data
 	queries := []string{
@@ -71,3 +135,254 @@ func (suite *DbTestSuite) TestBigDipperDb_GetAccounts() {
 		suite.Require().Equal(acc, accounts[index])
 	}
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAuthParams() {
+	params := authttypes.DefaultParams()
+	err := suite.database.SaveAuthParams(types.NewAuthParams(params, 10))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AuthParamsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM auth_params`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	var stored authttypes.Params
+	err = json.Unmarshal([]byte(rows[0].Params), &stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, stored)
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveVestingAccounts() {
+	address, err := sdk.AccAddressFromBech32("cosmos140xsjjg6pwkjp0xjz8zru7ytha60l5aee9nlf7")
+	suite.Require().NoError(err)
+
+	startTime := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	periodic := vestingtypes.NewPeriodicVestingAccount(
+		authttypes.NewBaseAccountWithAddress(address),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(300))),
+		startTime.Unix(),
+		vestingtypes.Periods{
+			{Length: 3600, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))},
+			{Length: 7200, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))},
+		},
+	)
+
+	err = suite.database.SaveVestingAccounts([]exported.VestingAccount{periodic})
+	suite.Require().NoError(err)
+
+	var accountRows []dbtypes.VestingAccountRow
+	err = suite.database.Sqlx.Select(&accountRows, `SELECT * FROM vesting_account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(accountRows, 1)
+	suite.Require().Equal("cosmos.vesting.v1beta1.PeriodicVestingAccount", accountRows[0].Type)
+	suite.Require().True(accountRows[0].StartTime.Time.Equal(startTime))
+	suite.Require().True(accountRows[0].EndTime.Time.Equal(startTime.Add(3 * time.Hour)))
+
+	var periodRows []dbtypes.VestingPeriodRow
+	err = suite.database.Sqlx.Select(&periodRows, `SELECT * FROM vesting_period ORDER BY period_order`)
+	suite.Require().NoError(err)
+	suite.Require().Len(periodRows, 2)
+	suite.Require().Equal(int64(7200), periodRows[1].Length)
+
+	// Override the account with a permanent locked one
+	locked := vestingtypes.NewPermanentLockedAccount(
+		authttypes.NewBaseAccountWithAddress(address),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(300))),
+	)
+
+	err = suite.database.SaveVestingAccounts([]exported.VestingAccount{locked})
+	suite.Require().NoError(err)
+
+	accountRows = nil
+	err = suite.database.Sqlx.Select(&accountRows, `SELECT * FROM vesting_account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(accountRows, 1)
+	suite.Require().Equal("cosmos.vesting.v1beta1.PermanentLockedAccount", accountRows[0].Type)
+	suite.Require().False(accountRows[0].StartTime.Valid)
+	suite.Require().False(accountRows[0].EndTime.Valid)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM vesting_period`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveVestingUnlockCalendar() {
+	day := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	err := suite.database.SaveVestingUnlockCalendar([]types.VestingUnlock{
+		types.NewVestingUnlock(day, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin("udaric", sdk.NewInt(50)))),
+		types.NewVestingUnlock(day.AddDate(0, 0, 1), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))),
+	})
+	suite.Require().NoError(err)
+
+	// Replace the calendar
+	err = suite.database.SaveVestingUnlockCalendar([]types.VestingUnlock{
+		types.NewVestingUnlock(day, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))),
+		types.NewVestingUnlock(day.AddDate(0, 0, 1), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))),
+	})
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM vesting_unlock_calendar`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+
+	var monthly string
+	err = suite.database.SQL.QueryRow(
+		`SELECT amount FROM vesting_unlock_calendar_monthly WHERE month = '2020-01-01' AND denom = 'uatom'`,
+	).Scan(&monthly)
+	suite.Require().NoError(err)
+	suite.Require().Equal("300", monthly)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveModuleAccounts() {
+	moduleAccount := authttypes.NewEmptyModuleAccount("distribution", authttypes.Burner)
+
+	err := suite.database.SaveModuleAccounts([]types.ModuleAccount{types.NewModuleAccount(moduleAccount, 10)})
+	suite.Require().NoError(err)
+
+	// Update the permissions
+	moduleAccount.Permissions = []string{authttypes.Minter, authttypes.Burner}
+	err = suite.database.SaveModuleAccounts([]types.ModuleAccount{types.NewModuleAccount(moduleAccount, 20)})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ModuleAccountRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM module_account`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(moduleAccount.Address, rows[0].Address)
+	suite.Require().Equal("distribution", rows[0].Name)
+	suite.Require().Equal([]string{authttypes.Minter, authttypes.Burner}, []string(rows[0].Permissions))
+	suite.Require().Equal(int64(20), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountLabels() {
+	err := suite.database.SaveAccountLabels([]types.AccountLabel{
+		types.NewAccountLabel("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", "Exchange", "exchange"),
+		types.NewAccountLabel("cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a", "Treasury", "treasury"),
+	})
+	suite.Require().NoError(err)
+
+	// Reloading the labels should replace the existing ones
+	err = suite.database.SaveAccountLabels([]types.AccountLabel{
+		types.NewAccountLabel("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", "Hot wallet", ""),
+	})
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AccountLabelRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM account_label`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", rows[0].Address)
+	suite.Require().Equal("Hot wallet", rows[0].Label)
+	suite.Require().False(rows[0].Category.Valid)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveMultisigAccount() {
+	members := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+	pubKey := multisig.NewLegacyAminoPubKey(2, members)
+	account := types.NewMultisigAccount(pubKey, 100)
+
+	err := suite.database.SaveMultisigAccount(account)
+	suite.Require().NoError(err)
+
+	// Test double insertion
+	err = suite.database.SaveMultisigAccount(account)
+	suite.Require().NoError(err)
+
+	var threshold int64
+	err = suite.database.SQL.QueryRow(
+		`SELECT threshold FROM multisig_account WHERE address = $1`, account.Address,
+	).Scan(&threshold)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), threshold)
+
+	stored, err := suite.database.GetMultisigAccountMembers(account.Address)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{
+		sdk.AccAddress(members[0].Address()).String(),
+		sdk.AccAddress(members[1].Address()).String(),
+		sdk.AccAddress(members[2].Address()).String(),
+	}, stored)
+
+	// Store the signers
+	err = suite.database.SaveMultisigSigners([]types.MultisigSigner{
+		types.NewMultisigSigner("hash", account.Address, stored[0], 100),
+		types.NewMultisigSigner("hash", account.Address, stored[2], 100),
+	})
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM multisig_signer WHERE transaction_hash = 'hash'`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsActivity() {
+	address := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+	first := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	last := time.Date(2020, 1, 2, 00, 00, 00, 000, time.UTC)
+
+	err := suite.database.SaveAccountsActivity([]types.AccountActivity{
+		types.NewAccountActivity(address, "hash_2", 0, "cosmos.bank.v1beta1.MsgSend", 20, last),
+		types.NewAccountActivity(address, "hash_1", 0, "cosmos.bank.v1beta1.MsgSend", 10, first),
+	})
+	suite.Require().NoError(err)
+
+	// Storing new activities should increment the counts, while already stored ones should be ignored
+	err = suite.database.SaveAccountsActivity([]types.AccountActivity{
+		types.NewAccountActivity(address, "hash_1", 0, "cosmos.bank.v1beta1.MsgSend", 10, first),
+		types.NewAccountActivity(address, "hash_1", 1, "cosmos.staking.v1beta1.MsgDelegate", 10, first),
+	})
+	suite.Require().NoError(err)
+
+	var firstHeight, lastHeight, messageCount int64
+	err = suite.database.SQL.QueryRow(
+		`SELECT first_height, last_height, message_count FROM account_activity WHERE address = $1`, address,
+	).Scan(&firstHeight, &lastHeight, &messageCount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), firstHeight)
+	suite.Require().Equal(int64(20), lastHeight)
+	suite.Require().Equal(int64(3), messageCount)
+
+	var sendCount int64
+	err = suite.database.SQL.QueryRow(
+		`SELECT count FROM account_message_count WHERE address = $1 AND type = 'cosmos.bank.v1beta1.MsgSend'`, address,
+	).Scan(&sendCount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), sendCount)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAccountsFeesPaid() {
+	address := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+
+	err := suite.database.SaveAccountsFeesPaid([]types.AccountFees{
+		types.NewAccountFees(address, "hash_1", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), 10),
+		types.NewAccountFees(address, "hash_2", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50)), sdk.NewCoin("udaric", sdk.NewInt(10))), 11),
+	})
+	suite.Require().NoError(err)
+
+	// Storing the fees of the same transaction twice should not change the amounts
+	err = suite.database.SaveAccountsFeesPaid([]types.AccountFees{
+		types.NewAccountFees(address, "hash_1", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), 10),
+		types.NewAccountFees(address, "hash_3", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(25))), 12),
+	})
+	suite.Require().NoError(err)
+
+	var amount string
+	err = suite.database.SQL.QueryRow(
+		`SELECT amount FROM account_fees_paid WHERE address = $1 AND denom = 'uatom'`, address,
+	).Scan(&amount)
+	suite.Require().NoError(err)
+	suite.Require().Equal("175", amount)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM account_fee_payment`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(4, count)
+}
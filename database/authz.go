@@ -0,0 +1,87 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// SaveAuthzGrant allows to store the given authz grant for the given block height
+func (db *Db) SaveAuthzGrant(grant types.AuthzGrant) error {
+	err := db.SaveAccounts([]types.Account{types.NewAccount(grant.Granter), types.NewAccount(grant.Grantee)})
+	if err != nil {
+		return fmt.Errorf("error while storing authz grant accounts: %s", err)
+	}
+
+	stmt := `
+INSERT INTO authz_grant (granter_address, grantee_address, msg_type_url, authorization_value, expiration, expired, height) 
+VALUES ($1, $2, $3, $4, $5, false, $6) 
+ON CONFLICT ON CONSTRAINT unique_authz_grant DO UPDATE 
+    SET authorization_value = excluded.authorization_value,
+        expiration = excluded.expiration,
+        expired = excluded.expired,
+        height = excluded.height
+WHERE authz_grant.height <= excluded.height`
+
+	authorizationJSON, err := codec.ProtoMarshalJSON(grant.Authorization, nil)
+	if err != nil {
+		return fmt.Errorf("error while marshaling authz grant authorization: %s", err)
+	}
+
+	_, err = db.SQL.Exec(stmt,
+		grant.Granter, grant.Grantee, grant.MsgTypeURL, authorizationJSON,
+		dbtypes.TimeToNullTime(grant.Expiration), grant.Height)
+	if err != nil {
+		return fmt.Errorf("error while saving authz grant: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteAuthzGrant removes the given authz grant from the database
+func (db *Db) DeleteAuthzGrant(removal types.AuthzGrantRemoval) error {
+	stmt := `
+DELETE FROM authz_grant 
+WHERE granter_address = $1 AND grantee_address = $2 AND msg_type_url = $3 AND height <= $4`
+
+	_, err := db.SQL.Exec(stmt, removal.Granter, removal.Grantee, removal.MsgTypeURL, removal.Height)
+	if err != nil {
+		return fmt.Errorf("error while deleting authz grant: %s", err)
+	}
+
+	return nil
+}
+
+// SetAuthzGrantsExpired marks as expired all the authz grants having an expiration not after the given time,
+// returning the number of grants that have been marked. This matches the chain, which prunes
+// the grants expiring at the block time too
+func (db *Db) SetAuthzGrantsExpired(blockTime time.Time) (int64, error) {
+	stmt := `
+UPDATE authz_grant SET expired = true 
+WHERE expired = false AND expiration IS NOT NULL AND expiration <= $1`
+
+	res, err := db.SQL.Exec(stmt, blockTime)
+	if err != nil {
+		return 0, fmt.Errorf("error while marking authz grants as expired: %s", err)
+	}
+
+	return res.RowsAffected()
+}
+
+// GetAuthzGranters returns the addresses of all the granters having at least one authz grant stored
+func (db *Db) GetAuthzGranters() ([]string, error) {
+	var granters []string
+	err := db.Sqlx.Select(&granters, `SELECT DISTINCT granter_address FROM authz_grant ORDER BY granter_address`)
+	return granters, err
+}
+
+// GetAuthzGrantsByGranter returns all the stored authz grants given by the provided granter
+func (db *Db) GetAuthzGrantsByGranter(granter string) ([]dbtypes.AuthzGrantRow, error) {
+	var rows []dbtypes.AuthzGrantRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM authz_grant WHERE granter_address = $1`, granter)
+	return rows, err
+}
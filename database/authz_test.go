@@ -0,0 +1,108 @@
+package database_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func (suite *DbTestSuite) getAuthzGrant(expiration *time.Time, height int64) types.AuthzGrant {
+	authorization := authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+	suite.Require().NoError(err)
+
+	return types.NewAuthzGrant(
+		"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
+		"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
+		authorization.MsgTypeURL(),
+		authztypes.Grant{Authorization: authorizationAny, Expiration: expiration},
+		height,
+	)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAuthzGrant() {
+	grant := suite.getAuthzGrant(nil, 100)
+
+	err := suite.database.SaveAuthzGrant(grant)
+	suite.Require().NoError(err)
+
+	// Test double insertion
+	err = suite.database.SaveAuthzGrant(grant)
+	suite.Require().NoError(err, "storing existing authz grant should return no error")
+
+	// Older grants should not override the newer ones
+	err = suite.database.SaveAuthzGrant(suite.getAuthzGrant(nil, 90))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AuthzGrantRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM authz_grant`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(grant.Granter, rows[0].Granter)
+	suite.Require().Equal(grant.Grantee, rows[0].Grantee)
+	suite.Require().Equal("/cosmos.bank.v1beta1.MsgSend", rows[0].MsgTypeURL)
+	suite.Require().Contains(rows[0].Authorization, "/cosmos.authz.v1beta1.GenericAuthorization")
+	suite.Require().False(rows[0].Expiration.Valid)
+	suite.Require().False(rows[0].Expired)
+	suite.Require().Equal(int64(100), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_DeleteAuthzGrant() {
+	grant := suite.getAuthzGrant(nil, 100)
+	err := suite.database.SaveAuthzGrant(grant)
+	suite.Require().NoError(err)
+
+	// Older removals should not delete the grant
+	err = suite.database.DeleteAuthzGrant(types.NewAuthzGrantRemoval(grant.Granter, grant.Grantee, grant.MsgTypeURL, 90))
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+
+	err = suite.database.DeleteAuthzGrant(types.NewAuthzGrantRemoval(grant.Granter, grant.Grantee, grant.MsgTypeURL, 110))
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM authz_grant`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SetAuthzGrantsExpired() {
+	expiration := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	err := suite.database.SaveAuthzGrant(suite.getAuthzGrant(&expiration, 100))
+	suite.Require().NoError(err)
+
+	// Sweep before the expiration
+	count, err := suite.database.SetAuthzGrantsExpired(expiration.Add(-time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), count)
+
+	// Sweep at the expiration, as the chain prunes the grants expiring at the block time too
+	count, err = suite.database.SetAuthzGrantsExpired(expiration)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), count)
+
+	var rows []dbtypes.AuthzGrantRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM authz_grant`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Expired)
+	suite.Require().True(rows[0].Expiration.Time.Equal(expiration))
+
+	var active int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM active_authz_grant`).Scan(&active)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, active)
+
+	granters, err := suite.database.GetAuthzGranters()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt"}, granters)
+}
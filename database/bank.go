@@ -1,12 +1,18 @@
 package database
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/lib/pq"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 // SaveSupply allows to save for the given height the given total amount of coins
@@ -24,5 +30,161 @@ WHERE supply.height <= excluded.height`
 		return fmt.Errorf("error while storing supply: %s", err)
 	}
 
+	query = `
+INSERT INTO supply_history (coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (height) DO UPDATE 
+    SET coins = excluded.coins`
+	_, err = db.SQL.Exec(query, pq.Array(dbtypes.NewDbCoins(coins)), height)
+	if err != nil {
+		return fmt.Errorf("error while storing supply history: %s", err)
+	}
+
+	return nil
+}
+
+// GetSupplyBefore returns the latest supply stored inside the history for a block
+// having a timestamp lower than the given one, or nil if no such supply exists
+func (db *Db) GetSupplyBefore(timestamp time.Time) (*dbtypes.SupplyHistoryRow, error) {
+	stmt := `
+SELECT supply_history.* FROM supply_history 
+JOIN block ON block.height = supply_history.height 
+WHERE block.timestamp < $1 
+ORDER BY supply_history.height DESC 
+LIMIT 1`
+
+	var rows []dbtypes.SupplyHistoryRow
+	err := db.Sqlx.Select(&rows, stmt, timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting supply history: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveSupplyChanges allows to store the given supply changes
+func (db *Db) SaveSupplyChanges(changes []types.SupplyChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO supply_change (type, origin, address, denom, amount, transaction_hash, event_index, height) 
+VALUES `
+
+	var args []interface{}
+	for i, change := range changes {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+
+		var txHash sql.NullString
+		if change.TxHash != "" {
+			txHash = sql.NullString{String: change.TxHash, Valid: true}
+		}
+
+		args = append(args,
+			change.Type, change.Origin, change.Address, change.Denom, change.Amount.String(),
+			txHash, change.EventIndex, change.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_supply_change DO UPDATE 
+	SET type = excluded.type,
+		origin = excluded.origin,
+		address = excluded.address,
+		denom = excluded.denom,
+		amount = excluded.amount,
+		transaction_hash = excluded.transaction_hash`
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing supply changes: %s", err)
+	}
+
 	return nil
 }
+
+// GetSupplyChangesTotals returns the total amounts minted and burned for each denom
+// between the given heights (start excluded, end included)
+func (db *Db) GetSupplyChangesTotals(startHeight, endHeight int64) ([]dbtypes.SupplyChangeTotalRow, error) {
+	stmt := `
+SELECT type, denom, SUM(amount) AS amount 
+FROM supply_change 
+WHERE height > $1 AND height <= $2 
+GROUP BY type, denom`
+
+	var rows []dbtypes.SupplyChangeTotalRow
+	err := db.Sqlx.Select(&rows, stmt, startHeight, endHeight)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting supply changes totals: %s", err)
+	}
+
+	return rows, nil
+}
+
+// SaveSupplyReconciliations allows to store the given supply reconciliations
+func (db *Db) SaveSupplyReconciliations(reconciliations []types.SupplyReconciliation) error {
+	if len(reconciliations) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO supply_reconciliation (date, denom, start_height, end_height, supply_delta, minted, burned, unexplained) 
+VALUES `
+
+	var args []interface{}
+	for i, r := range reconciliations {
+		ai := i * 8
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6, ai+7, ai+8)
+		args = append(args,
+			r.Date, r.Denom, r.StartHeight, r.EndHeight,
+			r.SupplyDelta.String(), r.Minted.String(), r.Burned.String(), r.Unexplained().String())
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (date, denom) DO UPDATE 
+	SET start_height = excluded.start_height,
+		end_height = excluded.end_height,
+		supply_delta = excluded.supply_delta,
+		minted = excluded.minted,
+		burned = excluded.burned,
+		unexplained = excluded.unexplained`
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing supply reconciliations: %s", err)
+	}
+
+	return nil
+}
+
+// SaveBankParams saves the given x/bank params inside the database
+func (db *Db) SaveBankParams(params types.BankParams) error {
+	paramsBz, err := json.Marshal(&params.Params)
+	if err != nil {
+		return fmt.Errorf("error while marshaling bank params: %s", err)
+	}
+
+	stmt := `
+INSERT INTO bank_params (params, height) 
+VALUES ($1, $2)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET params = excluded.params, 
+        height = excluded.height
+WHERE bank_params.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing bank params: %s", err)
+	}
+
+	return db.saveModuleParamsHistory(banktypes.ModuleName, paramsBz, params.Height)
+}
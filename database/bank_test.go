@@ -1,11 +1,16 @@
 package database_test
 
 import (
+	"encoding/json"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
 
 	bddbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
@@ -75,3 +80,104 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveSupply() {
 	suite.Require().Len(rows, 1, "supply table should contain only one row")
 	suite.Require().True(expected.Equals(rows[0]))
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveBankParams() {
+	params := banktypes.NewParams(true)
+	err := suite.database.SaveBankParams(types.NewBankParams(params, 10))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.BankParamsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM bank_params`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	var stored banktypes.Params
+	err = json.Unmarshal([]byte(rows[0].Params), &stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, stored)
+	suite.Require().Equal(int64(10), rows[0].Height)
+
+	// Verify the history
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM module_params_history WHERE module = 'bank'`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetSupplyBefore() {
+	block := suite.getBlock(10)
+
+	// No supply before the block
+	supply, err := suite.database.GetSupplyBefore(block.Timestamp)
+	suite.Require().NoError(err)
+	suite.Require().Nil(supply)
+
+	coins := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	err = suite.database.SaveSupply(coins, 10)
+	suite.Require().NoError(err)
+
+	supply, err = suite.database.GetSupplyBefore(block.Timestamp.Add(time.Second))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(supply)
+	suite.Require().Equal(int64(10), supply.Height)
+	suite.Require().Equal(coins, supply.Coins.ToCoins())
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveSupplyChanges() {
+	changes := []types.SupplyChange{
+		types.NewSupplyChange(
+			types.SupplyChangeTypeMint, types.SupplyChangeOriginInflation,
+			"cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q", "uatom", sdk.NewInt(100), "", 0, 10,
+		),
+		types.NewSupplyChange(
+			types.SupplyChangeTypeMint, types.SupplyChangeOriginInflation,
+			"cosmos1m3h30wlvsf8llruxtpukdvsy0km2kum8g38c8q", "uatom", sdk.NewInt(50), "", 0, 11,
+		),
+		types.NewSupplyChange(
+			types.SupplyChangeTypeBurn, types.SupplyChangeOriginGovDeposit,
+			"cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", "uatom", sdk.NewInt(30), "hash", 1, 11,
+		),
+	}
+	err := suite.database.SaveSupplyChanges(changes)
+	suite.Require().NoError(err)
+
+	// Storing the same changes again should not duplicate them
+	err = suite.database.SaveSupplyChanges(changes)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.SupplyChangeRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM supply_change ORDER BY height, event_index`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+	suite.Require().False(rows[0].TxHash.Valid)
+	suite.Require().Equal("hash", rows[2].TxHash.String)
+	suite.Require().Equal(types.SupplyChangeOriginGovDeposit, rows[2].Origin)
+
+	totals, err := suite.database.GetSupplyChangesTotals(10, 11)
+	suite.Require().NoError(err)
+	suite.Require().Len(totals, 2)
+	for _, total := range totals {
+		switch total.Type {
+		case types.SupplyChangeTypeMint:
+			suite.Require().Equal("50", total.Amount)
+		case types.SupplyChangeTypeBurn:
+			suite.Require().Equal("30", total.Amount)
+		}
+	}
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveSupplyReconciliations() {
+	date := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	err := suite.database.SaveSupplyReconciliations([]types.SupplyReconciliation{
+		types.NewSupplyReconciliation(date, "uatom", 10, 20, sdk.NewInt(100), sdk.NewInt(120), sdk.NewInt(10)),
+	})
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.SupplyReconciliationRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM supply_reconciliation`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(date.Equal(rows[0].Date))
+	suite.Require().Equal("100", rows[0].SupplyDelta)
+	suite.Require().Equal("-10", rows[0].Unexplained)
+}
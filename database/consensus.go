@@ -1,9 +1,13 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+
 	"github.com/forbole/bdjuno/v4/types"
 
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
@@ -41,6 +45,42 @@ func (db *Db) GetLastBlockHeightAndTimestamp() (dbtypes.BlockHeightAndTimestamp,
 	return blockHeightAndTimestamp[0], nil
 }
 
+// GetFirstBlockHeightAndTimestamp returns the first block height and timestamp stored inside the database
+func (db *Db) GetFirstBlockHeightAndTimestamp() (dbtypes.BlockHeightAndTimestamp, error) {
+	stmt := `SELECT height, timestamp FROM block ORDER BY height LIMIT 1`
+
+	var blockHeightAndTimestamp []dbtypes.BlockHeightAndTimestamp
+	if err := db.Sqlx.Select(&blockHeightAndTimestamp, stmt); err != nil {
+		return dbtypes.BlockHeightAndTimestamp{}, fmt.Errorf("cannot get first block height and timestamp from db: %s", err)
+	}
+
+	if len(blockHeightAndTimestamp) == 0 {
+		return dbtypes.BlockHeightAndTimestamp{}, nil
+	}
+
+	return blockHeightAndTimestamp[0], nil
+}
+
+// GetFirstBlocksOfDays returns, for each UTC day, the height and timestamp of the first block stored at or after
+// the given time of the day. Only the blocks between the given heights (both included) are considered,
+// and the result is ordered by height
+func (db *Db) GetFirstBlocksOfDays(fromHeight, toHeight int64, timeOfDay time.Duration) ([]dbtypes.BlockHeightAndTimestamp, error) {
+	// Shifting the timestamps back by the time of the day makes each day start at that time
+	stmt := `
+SELECT DISTINCT ON (date_trunc('day', timestamp - $3 * INTERVAL '1 second')) height, timestamp 
+FROM block 
+WHERE height >= $1 AND height <= $2 
+ORDER BY date_trunc('day', timestamp - $3 * INTERVAL '1 second'), height`
+
+	var blocks []dbtypes.BlockHeightAndTimestamp
+	err := db.Sqlx.Select(&blocks, stmt, fromHeight, toHeight, int64(timeOfDay.Seconds()))
+	if err != nil {
+		return nil, fmt.Errorf("error while getting first blocks of days: %s", err)
+	}
+
+	return blocks, nil
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // getBlockHeightTime retrieves the block at the specific time
@@ -188,3 +228,131 @@ func (db *Db) GetGenesis() (*types.Genesis, error) {
 	row := rows[0]
 	return types.NewGenesis(row.ChainID, row.Time, row.InitialHeight), nil
 }
+
+// SaveConsensusParams saves the given consensus params inside the database
+func (db *Db) SaveConsensusParams(params types.ConsensusParams) error {
+	paramsBz, err := json.Marshal(&params.ConsensusParams)
+	if err != nil {
+		return fmt.Errorf("error while marshaling consensus params: %s", err)
+	}
+
+	stmt := `
+INSERT INTO consensus_params (params, height) 
+VALUES ($1, $2)
+ON CONFLICT (one_row_id) DO UPDATE 
+    SET params = excluded.params, 
+        height = excluded.height
+WHERE consensus_params.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, string(paramsBz), params.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing consensus params: %s", err)
+	}
+
+	return db.saveModuleParamsHistory(consensustypes.ModuleName, paramsBz, params.Height)
+}
+
+// SaveBlockGasUsage saves the given block gas usage, computing its utilization against the max_gas consensus param
+// that was in place at the block height
+func (db *Db) SaveBlockGasUsage(usage types.BlockGasUsage) error {
+	stmt := `
+INSERT INTO block_gas_usage (height, gas_used, gas_wanted, max_gas, utilization)
+SELECT $1, $2, $3, max_gas, CASE WHEN max_gas > 0 THEN $2::DOUBLE PRECISION / max_gas END
+FROM (
+    SELECT COALESCE(
+        (SELECT (params -> 'block' ->> 'max_gas')::BIGINT FROM module_params_history 
+         WHERE module = $4 AND height <= $1 ORDER BY height DESC LIMIT 1),
+        (SELECT (params -> 'block' ->> 'max_gas')::BIGINT FROM consensus_params)
+    ) AS max_gas
+) AS params
+ON CONFLICT (height) DO UPDATE 
+    SET gas_used = excluded.gas_used,
+        gas_wanted = excluded.gas_wanted,
+        max_gas = excluded.max_gas,
+        utilization = excluded.utilization`
+
+	_, err := db.SQL.Exec(stmt, usage.Height, usage.GasUsed, usage.GasWanted, consensustypes.ModuleName)
+	if err != nil {
+		return fmt.Errorf("error while storing block gas usage: %s", err)
+	}
+
+	return nil
+}
+
+// SaveBlockGasPrices saves the given gas prices stats of the block at the given height
+func (db *Db) SaveBlockGasPrices(height int64, prices []types.GasPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO block_gas_price (height, denom, min_gas_price, median_gas_price, p90_gas_price, total_fees, tx_count) VALUES `
+	var params []interface{}
+
+	for i, price := range prices {
+		pi := i * 7
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", pi+1, pi+2, pi+3, pi+4, pi+5, pi+6, pi+7)
+		params = append(params, height, price.Denom,
+			price.MinGasPrice.String(), price.MedianGasPrice.String(), price.P90GasPrice.String(),
+			price.TotalFees.String(), price.TxCount)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (height, denom) DO UPDATE 
+    SET min_gas_price = excluded.min_gas_price,
+        median_gas_price = excluded.median_gas_price,
+        p90_gas_price = excluded.p90_gas_price,
+        total_fees = excluded.total_fees,
+        tx_count = excluded.tx_count`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing block gas prices: %s", err)
+	}
+
+	return nil
+}
+
+// GetSuggestedGasPrices returns, for each fee denom, the 25th, 50th and 90th percentiles
+// of the gas prices paid by the transactions included inside the blocks starting from the given height
+func (db *Db) GetSuggestedGasPrices(fromHeight int64) ([]types.SuggestedGasPrice, error) {
+	stmt := `
+SELECT coin ->> 'denom' AS denom,
+       ROUND(percentile_cont(0.25) WITHIN GROUP (ORDER BY (coin ->> 'amount')::NUMERIC / gas_wanted)::NUMERIC, 18) AS low,
+       ROUND(percentile_cont(0.5) WITHIN GROUP (ORDER BY (coin ->> 'amount')::NUMERIC / gas_wanted)::NUMERIC, 18) AS average,
+       ROUND(percentile_cont(0.9) WITHIN GROUP (ORDER BY (coin ->> 'amount')::NUMERIC / gas_wanted)::NUMERIC, 18) AS high
+FROM transaction
+    CROSS JOIN LATERAL jsonb_array_elements(fee -> 'amount') AS coin
+WHERE height >= $1 AND gas_wanted > 0 AND jsonb_typeof(fee -> 'amount') = 'array'
+GROUP BY coin ->> 'denom'
+ORDER BY coin ->> 'denom'`
+
+	var rows []dbtypes.SuggestedGasPriceRow
+	err := db.Sqlx.Select(&rows, stmt, fromHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]types.SuggestedGasPrice, len(rows))
+	for i, row := range rows {
+		low, err := sdk.NewDecFromStr(row.Low)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing %s low gas price: %s", row.Denom, err)
+		}
+
+		average, err := sdk.NewDecFromStr(row.Average)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing %s average gas price: %s", row.Denom, err)
+		}
+
+		high, err := sdk.NewDecFromStr(row.High)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing %s high gas price: %s", row.Denom, err)
+		}
+
+		prices[i] = types.NewSuggestedGasPrice(row.Denom, low, average, high)
+	}
+
+	return prices, nil
+}
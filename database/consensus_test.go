@@ -1,8 +1,13 @@
 package database_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
 	dbtypes "github.com/forbole/bdjuno/v4/database/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
@@ -345,3 +350,89 @@ func (suite *DbTestSuite) TestSaveConsensus_GetGenesis() {
 		0,
 	)))
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveConsensusParams() {
+	params := tmtypes.DefaultConsensusParams().ToProto()
+	err := suite.database.SaveConsensusParams(types.NewConsensusParams(params, 10))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ConsensusParamsRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM consensus_params`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+
+	var stored cmtproto.ConsensusParams
+	err = json.Unmarshal([]byte(rows[0].Params), &stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, stored)
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveBlockGasUsage() {
+	params := tmtypes.DefaultConsensusParams().ToProto()
+	params.Block.MaxGas = 1000
+	err := suite.database.SaveConsensusParams(types.NewConsensusParams(params, 10))
+	suite.Require().NoError(err)
+
+	// Params changed later on should not be used
+	params.Block.MaxGas = 4000
+	err = suite.database.SaveConsensusParams(types.NewConsensusParams(params, 20))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBlockGasUsage(types.NewBlockGasUsage(15, 250, 400))
+	suite.Require().NoError(err)
+
+	var maxGas int64
+	var utilization float64
+	err = suite.database.SQL.QueryRow(
+		`SELECT max_gas, utilization FROM block_gas_usage WHERE height = 15`,
+	).Scan(&maxGas, &utilization)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1000), maxGas)
+	suite.Require().Equal(0.25, utilization)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_GetFirstBlocksOfDays() {
+	_, err := suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
+	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
+	suite.Require().NoError(err)
+
+	timestamps := []string{
+		"2020-01-01T00:10:00Z",
+		"2020-01-01T08:00:00Z",
+		"2020-01-01T08:40:00Z",
+		"2020-01-01T20:00:00Z",
+		"2020-01-02T00:10:00Z",
+		"2020-01-02T09:00:00Z",
+	}
+	for i, value := range timestamps {
+		timestamp, err := time.Parse(time.RFC3339, value)
+		suite.Require().NoError(err)
+
+		_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
+	VALUES ($1, $2, '0', '0', 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $3)`, i+1, fmt.Sprintf("HASH%d", i+1), timestamp)
+		suite.Require().NoError(err)
+	}
+
+	// At midnight the first block of each UTC day is returned
+	blocks, err := suite.database.GetFirstBlocksOfDays(1, 6, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(blocks, 2)
+	suite.Require().Equal(int64(1), blocks[0].Height)
+	suite.Require().Equal(int64(5), blocks[1].Height)
+
+	// At a later time the first block at or after that time is returned instead
+	blocks, err = suite.database.GetFirstBlocksOfDays(1, 6, 8*time.Hour+30*time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Len(blocks, 3)
+	suite.Require().Equal(int64(1), blocks[0].Height) // First block after the snapshot time of the day before
+	suite.Require().Equal(int64(3), blocks[1].Height)
+	suite.Require().Equal(int64(6), blocks[2].Height)
+
+	// Only the blocks between the given heights are considered
+	blocks, err = suite.database.GetFirstBlocksOfDays(4, 6, 8*time.Hour+30*time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Len(blocks, 2)
+	suite.Require().Equal(int64(4), blocks[0].Height)
+	suite.Require().Equal(int64(6), blocks[1].Height)
+}
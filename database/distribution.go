@@ -9,6 +9,7 @@ import (
 	"github.com/forbole/bdjuno/v4/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/lib/pq"
 )
 
@@ -26,6 +27,75 @@ WHERE community_pool.height <= excluded.height`
 		return fmt.Errorf("error while storing community pool: %s", err)
 	}
 
+	return db.saveCommunityPoolHistory(coin, height)
+}
+
+// saveCommunityPoolHistory stores the given community pool inside the community pool history
+func (db *Db) saveCommunityPoolHistory(coin sdk.DecCoins, height int64) error {
+	query := `
+INSERT INTO community_pool_history(coins, height) 
+VALUES ($1, $2) 
+ON CONFLICT (height) DO UPDATE 
+    SET coins = excluded.coins`
+	_, err := db.SQL.Exec(query, pq.Array(dbtypes.NewDbDecCoins(coin)), height)
+	if err != nil {
+		return fmt.Errorf("error while storing community pool history: %s", err)
+	}
+
+	return nil
+}
+
+// SaveCommunityPoolDeposit allows to store the given community pool deposit
+func (db *Db) SaveCommunityPoolDeposit(deposit types.CommunityPoolDeposit) error {
+	err := db.SaveAccounts([]types.Account{types.NewAccount(deposit.Depositor)})
+	if err != nil {
+		return fmt.Errorf("error while storing depositor account: %s", err)
+	}
+
+	stmt := `
+INSERT INTO community_pool_deposit (depositor_address, amount, transaction_hash, msg_index, height) 
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT ON CONSTRAINT unique_community_pool_deposit DO NOTHING`
+	_, err = db.SQL.Exec(stmt,
+		deposit.Depositor, pq.Array(dbtypes.NewDbCoins(deposit.Amount)), deposit.TxHash, deposit.MsgIndex, deposit.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing community pool deposit: %s", err)
+	}
+
+	return nil
+}
+
+// SaveCommunityPoolTax allows to store the given community tax collected in a block
+func (db *Db) SaveCommunityPoolTax(tax types.CommunityPoolTax) error {
+	stmt := `
+INSERT INTO community_pool_tax (amount, height) 
+VALUES ($1, $2)
+ON CONFLICT (height) DO UPDATE 
+    SET amount = excluded.amount`
+	_, err := db.SQL.Exec(stmt, pq.Array(dbtypes.NewDbDecCoins(tax.Amount)), tax.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing community pool tax: %s", err)
+	}
+
+	return nil
+}
+
+// SaveCommunityPoolSpend allows to store the given community pool spend
+func (db *Db) SaveCommunityPoolSpend(spend types.CommunityPoolSpend) error {
+	stmt := `
+INSERT INTO community_pool_spend (proposal_id, msg_index, recipient_address, amount, height) 
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT ON CONSTRAINT unique_community_pool_spend DO UPDATE 
+    SET recipient_address = excluded.recipient_address,
+        amount = excluded.amount,
+        height = excluded.height
+WHERE community_pool_spend.height <= excluded.height`
+	_, err := db.SQL.Exec(stmt,
+		spend.ProposalID, spend.MsgIndex, spend.Recipient, pq.Array(dbtypes.NewDbCoins(spend.Amount)), spend.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing community pool spend: %s", err)
+	}
+
 	return nil
 }
 
@@ -50,5 +120,215 @@ WHERE distribution_params.height <= excluded.height`
 		return fmt.Errorf("error while storing distribution params: %s", err)
 	}
 
+	return db.saveModuleParamsHistory(distrtypes.ModuleName, paramsBz, params.Height)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// SaveDelegatorRewardWithdrawals allows to store the given rewards withdrawals
+func (db *Db) SaveDelegatorRewardWithdrawals(withdrawals []types.DelegatorRewardWithdrawal) error {
+	if len(withdrawals) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO delegator_reward_withdrawal (delegator_address, validator_address, amount, transaction_hash, msg_index, height) 
+VALUES `
+
+	var accounts []types.Account
+	var args []interface{}
+	for i, withdrawal := range withdrawals {
+		ai := i * 6
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5, ai+6)
+
+		accounts = append(accounts, types.NewAccount(withdrawal.DelegatorAddress))
+		args = append(args,
+			withdrawal.DelegatorAddress, withdrawal.ValidatorAddress, pq.Array(dbtypes.NewDbCoins(withdrawal.Amount)),
+			withdrawal.TxHash, withdrawal.MsgIndex, withdrawal.Height)
+	}
+
+	// Store the delegators accounts
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing delegators accounts: %s", err)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT ON CONSTRAINT unique_delegator_reward_withdrawal DO NOTHING"
+
+	_, err = db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing delegator reward withdrawals: %s", err)
+	}
+
+	return nil
+}
+
+// SaveValidatorCommissionWithdrawals allows to store the given commission withdrawals
+func (db *Db) SaveValidatorCommissionWithdrawals(withdrawals []types.ValidatorCommissionWithdrawal) error {
+	if len(withdrawals) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_commission_withdrawal (validator_address, amount, transaction_hash, msg_index, height) 
+VALUES `
+
+	var args []interface{}
+	for i, withdrawal := range withdrawals {
+		ai := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5)
+		args = append(args,
+			withdrawal.ValidatorAddress, pq.Array(dbtypes.NewDbCoins(withdrawal.Amount)),
+			withdrawal.TxHash, withdrawal.MsgIndex, withdrawal.Height)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += " ON CONFLICT ON CONSTRAINT unique_validator_commission_withdrawal DO NOTHING"
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing validator commission withdrawals: %s", err)
+	}
+
+	return nil
+}
+
+// SaveDelegatorWithdrawAddresses allows to store the given delegators withdraw addresses
+func (db *Db) SaveDelegatorWithdrawAddresses(addresses []types.DelegatorWithdrawAddress) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO delegator_withdraw_address (delegator_address, withdraw_address, height) VALUES `
+
+	var accounts []types.Account
+	var args []interface{}
+	for i, address := range addresses {
+		ai := i * 3
+		stmt += fmt.Sprintf("($%d,$%d,$%d),", ai+1, ai+2, ai+3)
+
+		accounts = append(accounts, types.NewAccount(address.DelegatorAddress))
+		args = append(args, address.DelegatorAddress, address.WithdrawAddress, address.Height)
+	}
+
+	// Store the delegators accounts
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing delegators accounts: %s", err)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (delegator_address) DO UPDATE 
+	SET withdraw_address = excluded.withdraw_address,
+		height = excluded.height
+WHERE delegator_withdraw_address.height <= excluded.height`
+
+	_, err = db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing delegator withdraw addresses: %s", err)
+	}
+
+	return nil
+}
+
+// GetDelegatorWithdrawAddress returns the withdraw address of the given delegator,
+// or an empty string if it has never been set
+func (db *Db) GetDelegatorWithdrawAddress(delegator string) (string, error) {
+	var rows []dbtypes.DelegatorWithdrawAddressRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM delegator_withdraw_address WHERE delegator_address = $1`, delegator)
+	if err != nil {
+		return "", fmt.Errorf("error while getting delegator withdraw address: %s", err)
+	}
+
+	if len(rows) == 0 {
+		return "", nil
+	}
+
+	return rows[0].WithdrawAddress, nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveDelegatorRewardSnapshots allows to store the given delegators pending rewards snapshots
+func (db *Db) SaveDelegatorRewardSnapshots(snapshots []types.DelegatorRewardSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO delegator_reward_snapshot (delegator_address, validator_address, amount, height, timestamp) 
+VALUES `
+
+	var accounts []types.Account
+	var args []interface{}
+	for i, snapshot := range snapshots {
+		ai := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5)
+
+		accounts = append(accounts, types.NewAccount(snapshot.DelegatorAddress))
+		args = append(args,
+			snapshot.DelegatorAddress, snapshot.ValidatorAddress, pq.Array(dbtypes.NewDbDecCoins(snapshot.Amount)),
+			snapshot.Height, snapshot.Timestamp)
+	}
+
+	// Store the delegators accounts
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing delegators accounts: %s", err)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT ON CONSTRAINT unique_delegator_reward_snapshot DO UPDATE 
+	SET amount = excluded.amount,
+		timestamp = excluded.timestamp`
+
+	_, err = db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing delegator reward snapshots: %s", err)
+	}
+
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SaveValidatorsRewards allows to store the given validators outstanding rewards and accumulated commission
+func (db *Db) SaveValidatorsRewards(rewards []types.ValidatorRewards) error {
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	stmt := `
+INSERT INTO validator_rewards_history (validator_address, outstanding_rewards, commission, height, timestamp) 
+VALUES `
+
+	var args []interface{}
+	for i, reward := range rewards {
+		ai := i * 5
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4, ai+5)
+		args = append(args,
+			reward.ValidatorConsAddr,
+			pq.Array(dbtypes.NewDbDecCoins(reward.OutstandingRewards)),
+			pq.Array(dbtypes.NewDbDecCoins(reward.Commission)),
+			reward.Height,
+			reward.Timestamp,
+		)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (validator_address, height) DO UPDATE 
+	SET outstanding_rewards = excluded.outstanding_rewards,
+		commission = excluded.commission,
+		timestamp = excluded.timestamp`
+
+	_, err := db.SQL.Exec(stmt, args...)
+	if err != nil {
+		return fmt.Errorf("error while storing validators rewards: %s", err)
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package database_test
 
 import (
 	"encoding/json"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -94,3 +95,244 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveDistributionParams() {
 	suite.Require().Equal(distrParams, stored)
 	suite.Require().Equal(int64(10), rows[0].Height)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDelegatorRewardWithdrawals() {
+	withdrawals := []types.DelegatorRewardWithdrawal{
+		types.NewDelegatorRewardWithdrawal(
+			"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+			"hash", 0, 10,
+		),
+		types.NewDelegatorRewardWithdrawal(
+			"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			"cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn",
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200))),
+			"hash", 0, 10,
+		),
+	}
+	err := suite.database.SaveDelegatorRewardWithdrawals(withdrawals)
+	suite.Require().NoError(err)
+
+	// Saving the same withdrawals again should not duplicate them
+	err = suite.database.SaveDelegatorRewardWithdrawals(withdrawals)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.DelegatorRewardWithdrawalRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM delegator_reward_withdrawal ORDER BY validator_address`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	suite.Require().True(rows[0].Equals(dbtypes.NewDelegatorRewardWithdrawalRow(
+		"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		"cosmosvaloper1000ya26q2cmh399q4c5aaacd9lmmdqp90kw2jn",
+		dbtypes.NewDbCoins(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))),
+		"hash", 0, 10,
+	)))
+	suite.Require().True(rows[1].Equals(dbtypes.NewDelegatorRewardWithdrawalRow(
+		"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		dbtypes.NewDbCoins(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))),
+		"hash", 0, 10,
+	)))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorCommissionWithdrawals() {
+	withdrawals := []types.ValidatorCommissionWithdrawal{
+		types.NewValidatorCommissionWithdrawal(
+			"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+			"hash", 1, 10,
+		),
+	}
+	err := suite.database.SaveValidatorCommissionWithdrawals(withdrawals)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ValidatorCommissionWithdrawalRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_commission_withdrawal`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Equals(dbtypes.NewValidatorCommissionWithdrawalRow(
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		dbtypes.NewDbCoins(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))),
+		"hash", 1, 10,
+	)))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDelegatorWithdrawAddresses() {
+	delegator := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+
+	// Not set yet
+	address, err := suite.database.GetDelegatorWithdrawAddress(delegator)
+	suite.Require().NoError(err)
+	suite.Require().Empty(address)
+
+	err = suite.database.SaveDelegatorWithdrawAddresses([]types.DelegatorWithdrawAddress{
+		types.NewDelegatorWithdrawAddress(delegator, "cosmos1qpzgtwec63yhxz9hesj8ve0j3ytzhhqaqxrmxf", 10),
+	})
+	suite.Require().NoError(err)
+
+	// Updating with lower height should not modify the data
+	err = suite.database.SaveDelegatorWithdrawAddresses([]types.DelegatorWithdrawAddress{
+		types.NewDelegatorWithdrawAddress(delegator, "cosmos1rcp29q3hpd246n6qak7jluqep4v006cdsczfmx", 9),
+	})
+	suite.Require().NoError(err)
+
+	address, err = suite.database.GetDelegatorWithdrawAddress(delegator)
+	suite.Require().NoError(err)
+	suite.Require().Equal("cosmos1qpzgtwec63yhxz9hesj8ve0j3ytzhhqaqxrmxf", address)
+
+	// Updating with higher height should modify the data
+	err = suite.database.SaveDelegatorWithdrawAddresses([]types.DelegatorWithdrawAddress{
+		types.NewDelegatorWithdrawAddress(delegator, "cosmos1rcp29q3hpd246n6qak7jluqep4v006cdsczfmx", 11),
+	})
+	suite.Require().NoError(err)
+
+	address, err = suite.database.GetDelegatorWithdrawAddress(delegator)
+	suite.Require().NoError(err)
+	suite.Require().Equal("cosmos1rcp29q3hpd246n6qak7jluqep4v006cdsczfmx", address)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPool_History() {
+	err := suite.database.SaveCommunityPool(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(100))), 10)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveCommunityPool(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(200))), 11)
+	suite.Require().NoError(err)
+
+	// Storing an older pool should not change the current one, but should still be part of the history
+	err = suite.database.SaveCommunityPool(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(50))), 9)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 3)
+	suite.Require().Equal(int64(9), rows[0].Height)
+	suite.Require().Equal(int64(10), rows[1].Height)
+	suite.Require().Equal(int64(11), rows[2].Height)
+
+	expected := dbtypes.NewDbDecCoins(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(200))))
+	suite.Require().True(expected.Equal(&rows[2].Coins))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPoolDeposit() {
+	deposit := types.NewCommunityPoolDeposit(
+		"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+		"hash", 0, 10,
+	)
+	err := suite.database.SaveCommunityPoolDeposit(deposit)
+	suite.Require().NoError(err)
+
+	// Saving the same deposit again should not duplicate it
+	err = suite.database.SaveCommunityPoolDeposit(deposit)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolDepositRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_deposit`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", rows[0].Depositor)
+	suite.Require().Equal(deposit.Amount, rows[0].Amount.ToCoins())
+	suite.Require().Equal("hash", rows[0].TxHash)
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPoolTax() {
+	err := suite.database.SaveCommunityPoolTax(
+		types.NewCommunityPoolTax(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(2))), 10),
+	)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolTaxRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_tax`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(2))), rows[0].Amount.ToDecCoins())
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveCommunityPoolSpend() {
+	_ = suite.getProposalRow(1)
+
+	spend := types.NewCommunityPoolSpend(
+		1, 0, "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), 10,
+	)
+	err := suite.database.SaveCommunityPoolSpend(spend)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.CommunityPoolSpendRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM community_pool_spend`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(uint64(1), rows[0].ProposalID)
+	suite.Require().Equal("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", rows[0].Recipient)
+	suite.Require().Equal(spend.Amount, rows[0].Amount.ToCoins())
+	suite.Require().Equal(int64(10), rows[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDelegatorRewardSnapshots() {
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	snapshots := []types.DelegatorRewardSnapshot{
+		types.NewDelegatorRewardSnapshot(
+			"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs",
+			"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+			sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(100))),
+			10, timestamp,
+		),
+	}
+	err := suite.database.SaveDelegatorRewardSnapshots(snapshots)
+	suite.Require().NoError(err)
+
+	// Storing the snapshot again should update the amount
+	snapshots[0].Amount = sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(200)))
+	err = suite.database.SaveDelegatorRewardSnapshots(snapshots)
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.DelegatorRewardSnapshotRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM delegator_reward_snapshot`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", rows[0].DelegatorAddress)
+	suite.Require().Equal("cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl", rows[0].ValidatorAddress)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(200))), rows[0].Amount.ToDecCoins())
+	suite.Require().Equal(int64(10), rows[0].Height)
+	suite.Require().True(timestamp.Equal(rows[0].Timestamp))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveValidatorsRewards() {
+	validator := suite.getValidator(
+		"cosmosvalcons1qqqqrezrl53hujmpdch6d805ac75n220ku09rl",
+		"cosmosvaloper1rcp29q3hpd246n6qak7jluqep4v006cdsc2kkl",
+		"cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8",
+	)
+
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	err := suite.database.SaveValidatorsRewards([]types.ValidatorRewards{
+		types.NewValidatorRewards(
+			validator.GetConsAddr(),
+			sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(100))),
+			sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(10))),
+			10, timestamp,
+		),
+		types.NewValidatorRewards(
+			validator.GetConsAddr(),
+			sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(150))),
+			sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(15))),
+			11, timestamp.Add(time.Hour),
+		),
+	})
+	suite.Require().NoError(err)
+
+	var rows []bddbtypes.ValidatorRewardsHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM validator_rewards_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(validator.GetConsAddr(), rows[0].ValidatorAddress)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(100))), rows[0].OutstandingRewards.ToDecCoins())
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin("uatom", sdk.NewInt(10))), rows[0].Commission.ToDecCoins())
+	suite.Require().Equal(int64(11), rows[1].Height)
+	suite.Require().True(timestamp.Add(time.Hour).Equal(rows[1].Timestamp))
+}
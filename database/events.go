@@ -0,0 +1,145 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/forbole/bdjuno/v4/types"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+)
+
+// SaveTxEvents stores the given events of the transaction having the given hash,
+// replacing the ones that might have been stored before
+func (db *Db) SaveTxEvents(txHash string, events []types.Event) error {
+	return db.replaceEvents(`DELETE FROM event WHERE transaction_hash = $1`, []interface{}{txHash}, events)
+}
+
+// SaveBlockEvents stores the given events emitted from the given source of the block having the provided height,
+// replacing the ones that might have been stored before
+func (db *Db) SaveBlockEvents(height int64, source string, events []types.Event) error {
+	return db.replaceEvents(`DELETE FROM event WHERE height = $1 AND source = $2`, []interface{}{height, source}, events)
+}
+
+// replaceEvents deletes the events matching the given statement and stores the provided ones in their place.
+// Everything is done within a single database transaction so that readers never see the events partially replaced
+func (db *Db) replaceEvents(deleteStmt string, deleteParams []interface{}, events []types.Event) error {
+	tx, err := db.Sqlx.Beginx()
+	if err != nil {
+		return fmt.Errorf("error while beginning events transaction: %s", err)
+	}
+
+	_, err = tx.Exec(deleteStmt, deleteParams...)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while deleting events: %s", err)
+	}
+
+	err = saveEvents(tx, events)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error while committing events transaction: %s", err)
+	}
+
+	return nil
+}
+
+// eventAttributeRow represents a single attribute to be stored inside the event_attribute table
+type eventAttributeRow struct {
+	eventID int64
+	index   int
+	types.EventAttribute
+}
+
+func saveEvents(tx *sqlx.Tx, events []types.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	// Reserve the ids beforehand so that the attributes can reference their events
+	var ids []int64
+	err := tx.Select(&ids, `SELECT nextval('event_id_seq') FROM generate_series(1, $1)`, len(events))
+	if err != nil {
+		return fmt.Errorf("error while getting events ids: %s", err)
+	}
+
+	var attributes []eventAttributeRow
+	for i, event := range events {
+		for index, attribute := range event.Attributes {
+			attributes = append(attributes, eventAttributeRow{eventID: ids[i], index: index, EventAttribute: attribute})
+		}
+	}
+
+	paramsNumber := 7
+	maxEventsPerSlice := 65535 / paramsNumber
+	for start := 0; start < len(events); start += maxEventsPerSlice {
+		end := start + maxEventsPerSlice
+		if end > len(events) {
+			end = len(events)
+		}
+
+		err = insertEvents(tx, paramsNumber, ids[start:end], events[start:end])
+		if err != nil {
+			return fmt.Errorf("error while storing events: %s", err)
+		}
+	}
+
+	paramsNumber = 4
+	maxAttributesPerSlice := 65535 / paramsNumber
+	for start := 0; start < len(attributes); start += maxAttributesPerSlice {
+		end := start + maxAttributesPerSlice
+		if end > len(attributes) {
+			end = len(attributes)
+		}
+
+		err = insertEventAttributes(tx, paramsNumber, attributes[start:end])
+		if err != nil {
+			return fmt.Errorf("error while storing events attributes: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func insertEvents(tx *sqlx.Tx, paramsNumber int, ids []int64, events []types.Event) error {
+	stmt := `INSERT INTO event (id, height, source, transaction_hash, msg_index, index, type) VALUES `
+	var params []interface{}
+
+	for i, event := range events {
+		ei := i * paramsNumber
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d),", ei+1, ei+2, ei+3, ei+4, ei+5, ei+6, ei+7)
+
+		var msgIndex interface{}
+		if event.MsgIndex != nil {
+			msgIndex = int64(*event.MsgIndex)
+		}
+
+		params = append(params,
+			ids[i], event.Height, event.Source, dbtypes.ToNullString(event.TxHash), msgIndex, event.Index, event.Type)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	_, err := tx.Exec(stmt, params...)
+	return err
+}
+
+func insertEventAttributes(tx *sqlx.Tx, paramsNumber int, attributes []eventAttributeRow) error {
+	stmt := `INSERT INTO event_attribute (event_id, index, key, value) VALUES `
+	var params []interface{}
+
+	for i, attribute := range attributes {
+		ai := i * paramsNumber
+		stmt += fmt.Sprintf("($%d,$%d,$%d,$%d),", ai+1, ai+2, ai+3, ai+4)
+		params = append(params, attribute.eventID, attribute.index, attribute.Key, attribute.Value)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	_, err := tx.Exec(stmt, params...)
+	return err
+}
@@ -0,0 +1,52 @@
+package database_test
+
+import (
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTxEvents() {
+	msgIndex := uint32(0)
+	events := []types.Event{
+		types.NewEvent(10, types.EventSourceTx, "hash", &msgIndex, 0, "transfer", []types.EventAttribute{
+			types.NewEventAttribute("recipient", "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"),
+			types.NewEventAttribute("amount", "10uatom"),
+		}),
+		types.NewEvent(10, types.EventSourceTx, "hash", &msgIndex, 1, "message", []types.EventAttribute{
+			types.NewEventAttribute("action", "send"),
+		}),
+	}
+
+	err := suite.database.SaveTxEvents("hash", events)
+	suite.Require().NoError(err)
+
+	// Storing the events again should replace them
+	err = suite.database.SaveTxEvents("hash", events)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveBlockEvents(10, types.EventSourceEndBlock, []types.Event{
+		types.NewEvent(10, types.EventSourceEndBlock, "", nil, 0, "transfer", []types.EventAttribute{
+			types.NewEventAttribute("recipient", "cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a"),
+		}),
+	})
+	suite.Require().NoError(err)
+
+	var count int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM event`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, count)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM event_attribute`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(4, count)
+
+	var txHash string
+	err = suite.database.SQL.QueryRow(
+		`SELECT transaction_hash FROM events_by_attribute('transfer', 'recipient', 'cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs')`,
+	).Scan(&txHash)
+	suite.Require().NoError(err)
+	suite.Require().Equal("hash", txHash)
+
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM events_by_attribute('transfer', 'recipient')`).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, count)
+}
@@ -2,9 +2,12 @@ package database
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lib/pq"
 
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -19,10 +22,12 @@ func (db *Db) SaveFeeGrantAllowance(allowance types.FeeGrant) error {
 	}
 
 	stmt := `
-INSERT INTO fee_grant_allowance(grantee_address, granter_address, allowance, height) 
-VALUES ($1, $2, $3, $4) 
+INSERT INTO fee_grant_allowance(grantee_address, granter_address, allowance, expiration, expired, height) 
+VALUES ($1, $2, $3, $4, false, $5) 
 ON CONFLICT ON CONSTRAINT unique_fee_grant_allowance DO UPDATE 
     SET allowance = excluded.allowance,
+        expiration = excluded.expiration,
+        expired = excluded.expired,
         height = excluded.height
 WHERE fee_grant_allowance.height <= excluded.height`
 
@@ -31,7 +36,18 @@ WHERE fee_grant_allowance.height <= excluded.height`
 		return fmt.Errorf("error while marshaling grant allowance: %s", err)
 	}
 
-	_, err = db.SQL.Exec(stmt, allowance.Grantee, allowance.Granter, allowanceJSON, allowance.Height)
+	feeAllowance, err := allowance.GetGrant()
+	if err != nil {
+		return fmt.Errorf("error while getting grant allowance: %s", err)
+	}
+
+	expiration, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return fmt.Errorf("error while getting grant allowance expiration: %s", err)
+	}
+
+	_, err = db.SQL.Exec(stmt,
+		allowance.Grantee, allowance.Granter, allowanceJSON, dbtypes.TimeToNullTime(expiration), allowance.Height)
 	if err != nil {
 		return fmt.Errorf("error while saving fee grant allowance: %s", err)
 	}
@@ -49,3 +65,54 @@ func (db *Db) DeleteFeeGrantAllowance(allowance types.GrantRemoval) error {
 	}
 	return nil
 }
+
+// SetFeeGrantAllowancesExpired marks as expired all the fee grant allowances having an expiration
+// not after the given time, returning the number of allowances that have been marked.
+// This matches the chain, which prunes the allowances expiring at the block time too
+func (db *Db) SetFeeGrantAllowancesExpired(blockTime time.Time) (int64, error) {
+	stmt := `
+UPDATE fee_grant_allowance SET expired = true 
+WHERE expired = false AND expiration IS NOT NULL AND expiration <= $1`
+
+	res, err := db.SQL.Exec(stmt, blockTime)
+	if err != nil {
+		return 0, fmt.Errorf("error while marking fee grant allowances as expired: %s", err)
+	}
+
+	return res.RowsAffected()
+}
+
+// GetFeeGrantGranters returns the addresses of all the granters having at least one allowance stored
+func (db *Db) GetFeeGrantGranters() ([]string, error) {
+	var granters []string
+	err := db.Sqlx.Select(&granters, `SELECT DISTINCT granter_address FROM fee_grant_allowance ORDER BY granter_address`)
+	return granters, err
+}
+
+// GetFeeGrantAllowancesByGranter returns all the stored allowances given by the provided granter
+func (db *Db) GetFeeGrantAllowancesByGranter(granter string) ([]dbtypes.FeeAllowanceRow, error) {
+	var rows []dbtypes.FeeAllowanceRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM fee_grant_allowance WHERE granter_address = $1`, granter)
+	return rows, err
+}
+
+// SaveFeeGrantUsage allows to store the given fee grant usage
+func (db *Db) SaveFeeGrantUsage(usage types.FeeGrantUsage) error {
+	err := db.SaveAccounts([]types.Account{types.NewAccount(usage.Granter), types.NewAccount(usage.Grantee)})
+	if err != nil {
+		return fmt.Errorf("error while storing fee grant usage accounts: %s", err)
+	}
+
+	stmt := `
+INSERT INTO fee_grant_usage (transaction_hash, granter_address, grantee_address, fee, height) 
+VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT (transaction_hash) DO NOTHING`
+
+	_, err = db.SQL.Exec(stmt,
+		usage.TxHash, usage.Granter, usage.Grantee, pq.Array(dbtypes.NewDbCoins(usage.Fee)), usage.Height)
+	if err != nil {
+		return fmt.Errorf("error while saving fee grant usage: %s", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package database_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 
@@ -66,3 +68,67 @@ func (suite *DbTestSuite) TestBigDipperDb_RemoveFeeGrantAllowance() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(0, count)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SetFeeGrantAllowancesExpired() {
+	granter, err := sdk.AccAddressFromBech32("cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt")
+	suite.Require().NoError(err)
+
+	grantee, err := sdk.AccAddressFromBech32("cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn")
+	suite.Require().NoError(err)
+
+	expiration := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	allowance := &feegranttypes.BasicAllowance{SpendLimit: nil, Expiration: &expiration}
+	feeGrant, err := feegranttypes.NewGrant(granter, grantee, allowance)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, 121622))
+	suite.Require().NoError(err)
+
+	// Sweep before the expiration
+	count, err := suite.database.SetFeeGrantAllowancesExpired(expiration.Add(-time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), count)
+
+	// Sweep at the expiration, as the chain prunes the allowances expiring at the block time too
+	count, err = suite.database.SetFeeGrantAllowancesExpired(expiration)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), count)
+
+	var rows []dbtypes.FeeAllowanceRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_allowance`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().True(rows[0].Expired)
+	suite.Require().True(rows[0].Expiration.Time.Equal(expiration))
+
+	var active int
+	err = suite.database.SQL.QueryRow(`SELECT COUNT(*) FROM active_fee_grant_allowance`).Scan(&active)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, active)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveFeeGrantUsage() {
+	usage := types.NewFeeGrantUsage(
+		"hash",
+		"cosmos1ltzt0z992ke6qgmtjxtygwzn36km4cy6cqdknt",
+		"cosmos1re6zjpyczs0w7flrl6uacl0r4teqtyg62crjsn",
+		sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200))),
+		121622,
+	)
+	err := suite.database.SaveFeeGrantUsage(usage)
+	suite.Require().NoError(err)
+
+	// Test double insertion
+	err = suite.database.SaveFeeGrantUsage(usage)
+	suite.Require().NoError(err, "storing existing grant usage should return no error")
+
+	var rows []dbtypes.FeeGrantUsageRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM fee_grant_usage`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("hash", rows[0].TxHash)
+	suite.Require().Equal(usage.Granter, rows[0].Granter)
+	suite.Require().Equal(usage.Grantee, rows[0].Grantee)
+	suite.Require().Equal(usage.Fee, rows[0].Fee.ToCoins())
+	suite.Require().Equal(int64(121622), rows[0].Height)
+}
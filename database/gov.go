@@ -1,13 +1,17 @@
 package database
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/lib/pq"
@@ -35,7 +39,7 @@ WHERE gov_params.height <= excluded.height`
 		return fmt.Errorf("error while storing gov params: %s", err)
 	}
 
-	return nil
+	return db.saveModuleParamsHistory(govtypes.ModuleName, paramsBz, params.Height)
 }
 
 // GetGovParams returns the most recent governance parameters
@@ -61,6 +65,27 @@ func (db *Db) GetGovParams() (*types.GovParams, error) {
 	return types.NewGovParams(&params, row.Height), nil
 }
 
+// GetGovParamsAtHeight returns the governance parameters that were in effect at the given height,
+// or nil if they have not been stored yet
+func (db *Db) GetGovParamsAtHeight(height int64) (*types.GovParams, error) {
+	row, err := db.getModuleParamsHistoryAtHeight(govtypes.ModuleName, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if row == nil {
+		return nil, nil
+	}
+
+	var params govtypesv1.Params
+	err = json.Unmarshal([]byte(row.Params), &params)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGovParams(&params, row.Height), nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveProposals allows to save for the given height the given total amount of coins
@@ -142,7 +167,7 @@ ON CONFLICT (id) DO UPDATE
 // GetProposal returns the proposal with the given id, or nil if not found
 func (db *Db) GetProposal(id uint64) (types.Proposal, error) {
 	var rows []*dbtypes.ProposalRow
-	err := db.SQL.Select(&rows, `SELECT * FROM proposal WHERE id = $1`, id)
+	err := db.SQL.Select(&rows, `SELECT id, title, description, metadata, content, submit_time, deposit_end_time, voting_start_time, voting_end_time, proposer_address, status FROM proposal WHERE id = $1`, id)
 	if err != nil {
 		return types.Proposal{}, fmt.Errorf("error while getting proposal %d: %s", id, err)
 	}
@@ -265,6 +290,73 @@ WHERE proposal_deposit.height <= excluded.height`
 	return nil
 }
 
+// GetProposalDeposits returns all the deposits that have been made towards the proposal having the given id
+func (db *Db) GetProposalDeposits(proposalID uint64) ([]types.Deposit, error) {
+	var rows []dbtypes.DepositRow
+	err := db.Sqlx.Select(&rows, `SELECT * FROM proposal_deposit WHERE proposal_id = $1 ORDER BY height`, proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting deposits of proposal %d: %s", proposalID, err)
+	}
+
+	deposits := make([]types.Deposit, len(rows))
+	for index, row := range rows {
+		deposits[index] = types.NewDeposit(
+			proposalID,
+			row.Depositor,
+			row.Amount.ToCoins(),
+			row.Timestamp,
+			row.TransactionHash,
+			row.Height,
+		)
+	}
+
+	return deposits, nil
+}
+
+// SaveDepositOutcomes allows to save the refund or burn outcomes of the given proposal deposits
+func (db *Db) SaveDepositOutcomes(outcomes []types.ProposalDepositOutcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO proposal_deposit_outcome (proposal_id, depositor_address, amount, outcome, height) VALUES `
+	var param []interface{}
+	var accounts []types.Account
+	for i, outcome := range outcomes {
+		vi := i * 5
+
+		accounts = append(accounts, types.NewAccount(outcome.Depositor))
+
+		query += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", vi+1, vi+2, vi+3, vi+4, vi+5)
+		param = append(param, outcome.ProposalID,
+			outcome.Depositor,
+			pq.Array(dbtypes.NewDbCoins(outcome.Amount)),
+			outcome.Outcome,
+			outcome.Height,
+		)
+	}
+
+	// Store depositors accounts
+	err := db.SaveAccounts(accounts)
+	if err != nil {
+		return fmt.Errorf("error while storing depositors accounts: %s", err)
+	}
+
+	query = query[:len(query)-1] // Remove trailing ","
+	query += `
+ON CONFLICT ON CONSTRAINT unique_deposit_outcome DO UPDATE
+	SET amount = excluded.amount,
+		outcome = excluded.outcome,
+		height = excluded.height
+WHERE proposal_deposit_outcome.height <= excluded.height`
+	_, err = db.SQL.Exec(query, param...)
+	if err != nil {
+		return fmt.Errorf("error while storing deposit outcomes: %s", err)
+	}
+
+	return nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveVote allows to save for the given height and the message vote
@@ -330,6 +422,49 @@ WHERE proposal_tally_result.height <= excluded.height`
 	return nil
 }
 
+// SaveProposalTallyStatus allows to save the given status of the tally of a proposal
+func (db *Db) SaveProposalTallyStatus(status types.ProposalTallyStatus) error {
+	stmt := `
+INSERT INTO proposal_tally_status (proposal_id, turnout, yes_ratio, veto_ratio, quorum_reached, projected_outcome, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (proposal_id) DO UPDATE 
+	SET turnout = excluded.turnout,
+		yes_ratio = excluded.yes_ratio,
+		veto_ratio = excluded.veto_ratio,
+		quorum_reached = excluded.quorum_reached,
+		projected_outcome = excluded.projected_outcome,
+		height = excluded.height
+WHERE proposal_tally_status.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt,
+		status.ProposalID, status.Turnout.String(), status.YesRatio.String(), status.VetoRatio.String(),
+		status.QuorumReached, status.ProjectedOutcome, status.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing tally status for proposal %d: %s", status.ProposalID, err)
+	}
+
+	return nil
+}
+
+// SaveProposalExecutionResult allows to save the result of the execution of a passed proposal
+func (db *Db) SaveProposalExecutionResult(result types.ProposalExecutionResult) error {
+	stmt := `
+INSERT INTO proposal_execution_result (proposal_id, success, log, height)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (proposal_id) DO UPDATE 
+	SET success = excluded.success,
+		log = excluded.log,
+		height = excluded.height
+WHERE proposal_execution_result.height <= excluded.height`
+
+	_, err := db.SQL.Exec(stmt, result.ProposalID, result.Success, dbtypes.ToNullString(result.Log), result.Height)
+	if err != nil {
+		return fmt.Errorf("error while storing execution result for proposal %d: %s", result.ProposalID, err)
+	}
+
+	return nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 // SaveProposalStakingPoolSnapshot allows to save the given snapshot of the staking pool
@@ -355,6 +490,34 @@ WHERE proposal_staking_pool_snapshot.height <= excluded.height`
 	return nil
 }
 
+// GetProposalStakingPoolSnapshot returns the snapshot of the staking pool associated with the given proposal,
+// or nil if no snapshot has been stored yet
+func (db *Db) GetProposalStakingPoolSnapshot(proposalID uint64) (*types.PoolSnapshot, error) {
+	var bondedTokens, notBondedTokens string
+	var height int64
+
+	stmt := `SELECT bonded_tokens, not_bonded_tokens, height FROM proposal_staking_pool_snapshot WHERE proposal_id = $1`
+	err := db.SQL.QueryRow(stmt, proposalID).Scan(&bondedTokens, &notBondedTokens, &height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking pool snapshot for proposal %d: %s", proposalID, err)
+	}
+
+	bonded, ok := sdk.NewIntFromString(bondedTokens)
+	if !ok {
+		return nil, fmt.Errorf("invalid bonded tokens amount: %s", bondedTokens)
+	}
+
+	notBonded, ok := sdk.NewIntFromString(notBondedTokens)
+	if !ok {
+		return nil, fmt.Errorf("invalid not bonded tokens amount: %s", notBondedTokens)
+	}
+
+	return types.NewPoolSnapshot(bonded, notBonded, height), nil
+}
+
 // SaveProposalValidatorsStatusesSnapshots allows to save the given validator statuses snapshots
 func (db *Db) SaveProposalValidatorsStatusesSnapshots(snapshots []types.ProposalValidatorStatusSnapshot) error {
 	if len(snapshots) == 0 {
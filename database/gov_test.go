@@ -80,6 +80,48 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveGovParams() {
 	suite.Require().Equal(updated, stored)
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_GetGovParamsAtHeight() {
+	newParams := func(quorum string) govtypesv1.Params {
+		return govtypesv1.Params{
+			MinDeposit:             []sdk.Coin{sdk.NewCoin("uatom", sdk.NewInt(1000))},
+			MaxDepositPeriod:       testutils.NewDurationPointer(time.Duration(int64(300000000000))),
+			VotingPeriod:           testutils.NewDurationPointer(time.Duration(int64(300000))),
+			Quorum:                 quorum,
+			Threshold:              "0.5",
+			VetoThreshold:          "0.334",
+			MinInitialDepositRatio: "0",
+		}
+	}
+
+	// No params are stored yet
+	stored, err := suite.database.GetGovParamsAtHeight(10)
+	suite.Require().NoError(err)
+	suite.Require().Nil(stored)
+
+	oldParams := newParams("0.4")
+	err = suite.database.SaveGovParams(types.NewGovParams(&oldParams, 10))
+	suite.Require().NoError(err)
+
+	currentParams := newParams("0.5")
+	err = suite.database.SaveGovParams(types.NewGovParams(&currentParams, 20))
+	suite.Require().NoError(err)
+
+	// Heights before the first params should have no params
+	stored, err = suite.database.GetGovParamsAtHeight(9)
+	suite.Require().NoError(err)
+	suite.Require().Nil(stored)
+
+	// Heights between the two versions should use the older params
+	stored, err = suite.database.GetGovParamsAtHeight(15)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewGovParams(&oldParams, 10), stored)
+
+	// Heights after the latest change should use the current params
+	stored, err = suite.database.GetGovParamsAtHeight(20)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewGovParams(&currentParams, 20), stored)
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 func (suite *DbTestSuite) getProposalRow(id int) types.Proposal {
@@ -176,7 +218,7 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveProposals() {
 	suite.Require().NoError(err)
 
 	var proposalRow []dbtypes.ProposalRow
-	err = suite.database.Sqlx.Select(&proposalRow, `SELECT * FROM proposal ORDER BY id`)
+	err = suite.database.Sqlx.Select(&proposalRow, `SELECT id, title, description, metadata, content, submit_time, deposit_end_time, voting_start_time, voting_end_time, proposer_address, status FROM proposal ORDER BY id`)
 	suite.Require().NoError(err)
 
 	expected := []dbtypes.ProposalRow{
@@ -249,7 +291,7 @@ func (suite *DbTestSuite) TestBigDipperDb_GetProposal() {
 	suite.Require().NoError(err)
 
 	var rows []dbtypes.ProposalRow
-	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal`)
+	err = suite.database.Sqlx.Select(&rows, `SELECT id, title, description, metadata, content, submit_time, deposit_end_time, voting_start_time, voting_end_time, proposer_address, status FROM proposal`)
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, 1)
 }
@@ -370,7 +412,7 @@ func (suite *DbTestSuite) TestBigDipperDb_UpdateProposal() {
 	)
 
 	var stored dbtypes.ProposalRow
-	err = suite.database.SQL.Get(&stored, `SELECT * FROM proposal LIMIT 1`)
+	err = suite.database.SQL.Get(&stored, `SELECT id, title, description, metadata, content, submit_time, deposit_end_time, voting_start_time, voting_end_time, proposer_address, status FROM proposal LIMIT 1`)
 	suite.Require().NoError(err)
 	suite.Require().True(expected.Equals(stored))
 }
@@ -448,6 +490,80 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveDeposits() {
 	}
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_GetProposalDeposits() {
+	proposal := suite.getProposalRow(1)
+
+	depositor := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	amount := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(10000)))
+	timestamp := time.Date(2020, 1, 1, 15, 00, 00, 000, time.UTC)
+	txHash := "D40FE0C386FA85677FFB9B3C4CECD54CF2CD7ABECE4EF15FAEF328FCCBF4C3A8"
+
+	deposits := []types.Deposit{
+		types.NewDeposit(proposal.ID, depositor.String(), amount, timestamp, txHash, 10),
+	}
+	err := suite.database.SaveDeposits(deposits)
+	suite.Require().NoError(err)
+
+	stored, err := suite.database.GetProposalDeposits(proposal.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(stored, 1)
+	suite.Require().Equal(depositor.String(), stored[0].Depositor)
+	suite.Require().True(amount.IsEqual(stored[0].Amount))
+	suite.Require().Equal(txHash, stored[0].TransactionHash)
+	suite.Require().Equal(int64(10), stored[0].Height)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveDepositOutcomes() {
+	proposal := suite.getProposalRow(1)
+
+	depositor := suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	amount := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(10000)))
+
+	depositor2 := suite.getAccount("cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a")
+	amount2 := sdk.NewCoins(sdk.NewCoin("desmos", sdk.NewInt(30000)))
+
+	err := suite.database.SaveDepositOutcomes([]types.ProposalDepositOutcome{
+		types.NewProposalDepositOutcome(proposal.ID, depositor.String(), amount, types.DepositOutcomeRefunded, 10),
+		types.NewProposalDepositOutcome(proposal.ID, depositor2.String(), amount2, types.DepositOutcomeRefunded, 10),
+	})
+	suite.Require().NoError(err)
+
+	expected := []dbtypes.DepositOutcomeRow{
+		dbtypes.NewDepositOutcomeRow(1, depositor.String(), dbtypes.NewDbCoins(amount), types.DepositOutcomeRefunded, 10),
+		dbtypes.NewDepositOutcomeRow(1, depositor2.String(), dbtypes.NewDbCoins(amount2), types.DepositOutcomeRefunded, 10),
+	}
+
+	var result []dbtypes.DepositOutcomeRow
+	err = suite.database.Sqlx.Select(&result, `SELECT * FROM proposal_deposit_outcome`)
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	for i, r := range result {
+		suite.Require().True(expected[i].Equals(r))
+	}
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with lower and higher heights
+
+	err = suite.database.SaveDepositOutcomes([]types.ProposalDepositOutcome{
+		types.NewProposalDepositOutcome(proposal.ID, depositor.String(), amount, types.DepositOutcomeBurned, 9),
+		types.NewProposalDepositOutcome(proposal.ID, depositor2.String(), amount2, types.DepositOutcomeBurned, 11),
+	})
+	suite.Require().NoError(err)
+
+	expected = []dbtypes.DepositOutcomeRow{
+		dbtypes.NewDepositOutcomeRow(1, depositor2.String(), dbtypes.NewDbCoins(amount2), types.DepositOutcomeBurned, 11),
+		dbtypes.NewDepositOutcomeRow(1, depositor.String(), dbtypes.NewDbCoins(amount), types.DepositOutcomeRefunded, 10),
+	}
+
+	result = []dbtypes.DepositOutcomeRow{}
+	err = suite.database.Sqlx.Select(&result, `SELECT * FROM proposal_deposit_outcome ORDER BY height DESC`)
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	for i, r := range result {
+		suite.Require().True(expected[i].Equals(r))
+	}
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveVote() {
@@ -593,6 +709,119 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveTallyResults() {
 	}
 }
 
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalExecutionResult() {
+	suite.getProposalRow(1)
+
+	err := suite.database.SaveProposalExecutionResult(types.NewProposalExecutionResult(1, false, "failed", 10))
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalExecutionResultRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_execution_result`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalExecutionResultRow{
+		dbtypes.NewProposalExecutionResultRow(1, false, "failed", 10),
+	}, rows)
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with lower height
+	err = suite.database.SaveProposalExecutionResult(types.NewProposalExecutionResult(1, true, "", 9))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ProposalExecutionResultRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_execution_result`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalExecutionResultRow{
+		dbtypes.NewProposalExecutionResultRow(1, false, "failed", 10),
+	}, rows)
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with higher height
+	err = suite.database.SaveProposalExecutionResult(types.NewProposalExecutionResult(1, true, "", 11))
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ProposalExecutionResultRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_execution_result`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalExecutionResultRow{
+		dbtypes.NewProposalExecutionResultRow(1, true, "", 11),
+	}, rows)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveProposalTallyStatus() {
+	suite.getProposalRow(1)
+
+	params := &govtypesv1.Params{Quorum: "0.334", Threshold: "0.5", VetoThreshold: "0.334"}
+	tally := types.NewTallyResult(1, "300", "100", "50", "50", 10)
+
+	status, err := types.ComputeProposalTallyStatus(tally, params, sdk.NewInt(1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TallyOutcomePassed, status.ProjectedOutcome)
+
+	err = suite.database.SaveProposalTallyStatus(status)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.ProposalTallyStatusRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_status`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalTallyStatusRow{
+		dbtypes.NewProposalTallyStatusRow(1,
+			"0.500000000000000000", "0.750000000000000000", "0.100000000000000000",
+			true, types.TallyOutcomePassed, 10),
+	}, rows)
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with lower height
+	tally = types.NewTallyResult(1, "0", "0", "0", "0", 9)
+	status, err = types.ComputeProposalTallyStatus(tally, params, sdk.NewInt(1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TallyOutcomeNoQuorum, status.ProjectedOutcome)
+
+	err = suite.database.SaveProposalTallyStatus(status)
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ProposalTallyStatusRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_status`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal(types.TallyOutcomePassed, rows[0].ProjectedOutcome)
+
+	// ----------------------------------------------------------------------------------------------------------------
+	// Update with higher height
+	tally = types.NewTallyResult(1, "100", "0", "100", "200", 11)
+	status, err = types.ComputeProposalTallyStatus(tally, params, sdk.NewInt(1000))
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveProposalTallyStatus(status)
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ProposalTallyStatusRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM proposal_tally_status`)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]dbtypes.ProposalTallyStatusRow{
+		dbtypes.NewProposalTallyStatusRow(1,
+			"0.400000000000000000", "0.250000000000000000", "0.500000000000000000",
+			true, types.TallyOutcomeVetoed, 11),
+	}, rows)
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+func (suite *DbTestSuite) TestBigDipperDb_GetProposalStakingPoolSnapshot() {
+	suite.getProposalRow(1)
+
+	snapshot, err := suite.database.GetProposalStakingPoolSnapshot(1)
+	suite.Require().NoError(err)
+	suite.Require().Nil(snapshot)
+
+	pool := types.NewPoolSnapshot(sdk.NewInt(100), sdk.NewInt(20), 10)
+	err = suite.database.SaveProposalStakingPoolSnapshot(types.NewProposalStakingPoolSnapshot(1, pool))
+	suite.Require().NoError(err)
+
+	snapshot, err = suite.database.GetProposalStakingPoolSnapshot(1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool, snapshot)
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 func (suite *DbTestSuite) TestBigDipperDb_SaveProposalStakingPoolSnapshot() {
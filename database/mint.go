@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/forbole/bdjuno/v4/types"
 )
@@ -47,5 +48,46 @@ WHERE mint_params.height <= excluded.height`
 		return fmt.Errorf("error while storing mint params: %s", err)
 	}
 
+	return db.saveModuleParamsHistory(minttypes.ModuleName, paramsBz, params.Height)
+}
+
+// SaveAnnualProvisions allows to store the annual provisions for the given block height
+func (db *Db) SaveAnnualProvisions(annualProvisions sdk.Dec, height int64) error {
+	stmt := `
+INSERT INTO annual_provisions_history (value, height) 
+VALUES ($1, $2) 
+ON CONFLICT (height) DO UPDATE 
+    SET value = excluded.value`
+
+	_, err := db.SQL.Exec(stmt, annualProvisions.String(), height)
+	if err != nil {
+		return fmt.Errorf("error while storing annual provisions: %s", err)
+	}
+
+	return nil
+}
+
+// SaveBlockProvision allows to store the given block provision
+func (db *Db) SaveBlockProvision(provision types.BlockProvision) error {
+	stmt := `
+INSERT INTO block_provision (bonded_ratio, inflation, annual_provisions, amount, height) 
+VALUES ($1, $2, $3, $4, $5) 
+ON CONFLICT (height) DO UPDATE 
+    SET bonded_ratio = excluded.bonded_ratio, 
+        inflation = excluded.inflation, 
+        annual_provisions = excluded.annual_provisions, 
+        amount = excluded.amount`
+
+	_, err := db.SQL.Exec(stmt,
+		provision.BondedRatio.String(),
+		provision.Inflation.String(),
+		provision.AnnualProvisions.String(),
+		provision.Amount.String(),
+		provision.Height,
+	)
+	if err != nil {
+		return fmt.Errorf("error while storing block provision: %s", err)
+	}
+
 	return nil
 }
@@ -95,3 +95,54 @@ func (suite *DbTestSuite) TestBigDipperDb_SaveMintParams() {
 	suite.Require().Equal(mintParams, storedParams)
 	suite.Require().Equal(int64(10), rows[0].Height)
 }
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveAnnualProvisions() {
+	err := suite.database.SaveAnnualProvisions(sdk.NewDecWithPrec(10050, 2), 100)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAnnualProvisions(sdk.NewDecWithPrec(20000, 2), 101)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.AnnualProvisionsHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM annual_provisions_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().Equal(int64(100), rows[0].Height)
+	suite.Require().Equal(int64(101), rows[1].Height)
+
+	value, err := sdk.NewDecFromStr(rows[1].Value)
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.NewDecWithPrec(20000, 2).Equal(value))
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveBlockProvision() {
+	provision := types.NewBlockProvision(
+		sdk.NewDecWithPrec(67, 2),
+		sdk.NewDecWithPrec(13, 2),
+		sdk.NewDec(1000000),
+		sdk.NewInt(190),
+		100,
+	)
+	err := suite.database.SaveBlockProvision(provision)
+	suite.Require().NoError(err)
+
+	var rows []dbtypes.BlockProvisionRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM block_provision`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 1)
+	suite.Require().Equal("190", rows[0].Amount)
+	suite.Require().Equal(int64(100), rows[0].Height)
+
+	bondedRatio, err := sdk.NewDecFromStr(rows[0].BondedRatio)
+	suite.Require().NoError(err)
+	suite.Require().True(provision.BondedRatio.Equal(bondedRatio))
+
+	// Pruning the height should remove the provision
+	err = suite.database.Prune(100)
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.BlockProvisionRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM block_provision`)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rows)
+}
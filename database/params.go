@@ -0,0 +1,57 @@
+package database
+
+import (
+	"fmt"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+)
+
+// saveModuleParamsHistory stores the given JSON-encoded params of the given module inside the params history
+func (db *Db) saveModuleParamsHistory(module string, paramsBz []byte, height int64) error {
+	stmt := `
+INSERT INTO module_params_history (module, params, height) 
+VALUES ($1, $2, $3)
+ON CONFLICT ON CONSTRAINT unique_module_params_history DO UPDATE 
+    SET params = excluded.params`
+
+	_, err := db.SQL.Exec(stmt, module, string(paramsBz), height)
+	if err != nil {
+		return fmt.Errorf("error while storing %s params history: %s", module, err)
+	}
+
+	return nil
+}
+
+// SetModuleParamsProposal sets the id of the proposal that changed the params of the given module
+// at the given height
+func (db *Db) SetModuleParamsProposal(module string, height int64, proposalID uint64) error {
+	stmt := `UPDATE module_params_history SET proposal_id = $1 WHERE module = $2 AND height = $3`
+	_, err := db.SQL.Exec(stmt, proposalID, module, height)
+	if err != nil {
+		return fmt.Errorf("error while setting proposal of %s params history: %s", module, err)
+	}
+
+	return nil
+}
+
+// getModuleParamsHistoryAtHeight returns the params of the given module that were in effect at the given height,
+// or nil if no params have been stored for the module at or before that height
+func (db *Db) getModuleParamsHistoryAtHeight(module string, height int64) (*dbtypes.ModuleParamsHistoryRow, error) {
+	stmt := `
+SELECT * FROM module_params_history 
+WHERE module = $1 AND height <= $2 
+ORDER BY height DESC 
+LIMIT 1`
+
+	var rows []dbtypes.ModuleParamsHistoryRow
+	err := db.Sqlx.Select(&rows, stmt, module, height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting %s params history: %s", module, err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
@@ -0,0 +1,55 @@
+package database_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveModuleParamsHistory() {
+	distrParams := distrtypes.Params{
+		CommunityTax:        sdk.NewDecWithPrec(2, 2),
+		BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
+		BonusProposerReward: sdk.NewDecWithPrec(4, 2),
+		WithdrawAddrEnabled: true,
+	}
+	err := suite.database.SaveDistributionParams(types.NewDistributionParams(distrParams, 10))
+	suite.Require().NoError(err)
+
+	updatedParams := distrParams
+	updatedParams.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	err = suite.database.SaveDistributionParams(types.NewDistributionParams(updatedParams, 20))
+	suite.Require().NoError(err)
+
+	// Verify that both versions are stored
+	var rows []dbtypes.ModuleParamsHistoryRow
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM module_params_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+
+	for i, expected := range []distrtypes.Params{distrParams, updatedParams} {
+		var stored distrtypes.Params
+		err = json.Unmarshal([]byte(rows[i].Params), &stored)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, stored)
+		suite.Require().Equal(distrtypes.ModuleName, rows[i].Module)
+		suite.Require().False(rows[i].ProposalID.Valid)
+	}
+
+	// Link the second version to a proposal
+	proposal := suite.getProposalRow(1)
+	err = suite.database.SetModuleParamsProposal(distrtypes.ModuleName, 20, proposal.ID)
+	suite.Require().NoError(err)
+
+	rows = []dbtypes.ModuleParamsHistoryRow{}
+	err = suite.database.Sqlx.Select(&rows, `SELECT * FROM module_params_history ORDER BY height`)
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	suite.Require().False(rows[0].ProposalID.Valid)
+	suite.Require().True(rows[1].ProposalID.Valid)
+	suite.Require().Equal(int64(proposal.ID), rows[1].ProposalID.Int64)
+}
@@ -11,6 +11,21 @@ func (db *Db) Prune(height int64) error {
 	}
 
 	// Prune modules
+	err = db.pruneConsensus(height)
+	if err != nil {
+		return fmt.Errorf("error while pruning consensus: %s", err)
+	}
+
+	err = db.pruneEvents(height)
+	if err != nil {
+		return fmt.Errorf("error while pruning events: %s", err)
+	}
+
+	err = db.pruneStats(height)
+	if err != nil {
+		return fmt.Errorf("error while pruning stats: %s", err)
+	}
+
 	err = db.pruneBank(height)
 	if err != nil {
 		return fmt.Errorf("error while pruning bank: %s", err)
@@ -39,6 +54,38 @@ func (db *Db) Prune(height int64) error {
 	return nil
 }
 
+func (db *Db) pruneConsensus(height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM block_gas_usage WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning block gas usage: %s", err)
+	}
+
+	_, err = db.SQL.Exec(`DELETE FROM block_gas_price WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning block gas prices: %s", err)
+	}
+
+	return nil
+}
+
+func (db *Db) pruneEvents(height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM event WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning events: %s", err)
+	}
+
+	return nil
+}
+
+func (db *Db) pruneStats(height int64) error {
+	_, err := db.SQL.Exec(`DELETE FROM transaction_error WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning transaction errors: %s", err)
+	}
+
+	return nil
+}
+
 func (db *Db) pruneBank(height int64) error {
 	_, err := db.SQL.Exec(`DELETE FROM supply WHERE height = $1`, height)
 	if err != nil {
@@ -83,7 +130,21 @@ func (db *Db) pruneStaking(height int64) error {
 
 func (db *Db) pruneMint(height int64) error {
 	_, err := db.SQL.Exec(`DELETE FROM inflation WHERE height = $1`, height)
-	return fmt.Errorf("error while pruning inflation: %s", err)
+	if err != nil {
+		return fmt.Errorf("error while pruning inflation: %s", err)
+	}
+
+	_, err = db.SQL.Exec(`DELETE FROM annual_provisions_history WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning annual provisions history: %s", err)
+	}
+
+	_, err = db.SQL.Exec(`DELETE FROM block_provision WHERE height = $1`, height)
+	if err != nil {
+		return fmt.Errorf("error while pruning block provisions: %s", err)
+	}
+
+	return nil
 }
 
 func (db *Db) pruneDistribution(height int64) error {
@@ -101,10 +162,5 @@ func (db *Db) pruneSlashing(height int64) error {
 		return fmt.Errorf("error while pruning validator signing info: %s", err)
 	}
 
-	_, err = db.SQL.Exec(`DELETE FROM slashing_params WHERE height = $1`, height)
-	if err != nil {
-		return fmt.Errorf("error while pruning slashing params: %s", err)
-	}
-
 	return nil
 }
@@ -1,6 +1,122 @@
 CREATE TABLE account
 (
-    address TEXT NOT NULL PRIMARY KEY
+    address           TEXT   NOT NULL PRIMARY KEY,
+
+    /* ---- the following columns are empty until the account is found on chain ---- */
+    type              TEXT,
+    pubkey_type       TEXT,
+    pubkey            JSONB,
+    account_number    BIGINT,
+    sequence          BIGINT,
+    first_seen_height BIGINT,
+    height            BIGINT
+);
+CREATE INDEX account_type_index ON account (type);
+CREATE INDEX account_account_number_index ON account (account_number);
+
+CREATE TABLE module_account
+(
+    address     TEXT   NOT NULL PRIMARY KEY REFERENCES account (address),
+    name        TEXT   NOT NULL UNIQUE,
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    height      BIGINT NOT NULL
+);
+
+/* ---- Labels loaded from the configured registry ---- */
+CREATE TABLE account_label
+(
+    address  TEXT NOT NULL PRIMARY KEY REFERENCES account (address),
+    label    TEXT NOT NULL,
+    category TEXT
+);
+CREATE INDEX account_label_category_index ON account_label (category);
+
+CREATE TABLE multisig_account
+(
+    address   TEXT   NOT NULL PRIMARY KEY REFERENCES account (address),
+    threshold BIGINT NOT NULL,
+    height    BIGINT NOT NULL
+);
+
+CREATE TABLE multisig_account_member
+(
+    multisig_address TEXT   NOT NULL REFERENCES multisig_account (address),
+    member_index     BIGINT NOT NULL,
+    member_address   TEXT   NOT NULL REFERENCES account (address),
+    pubkey_type      TEXT   NOT NULL,
+    pubkey           JSONB  NOT NULL,
+    PRIMARY KEY (multisig_address, member_index)
+);
+CREATE INDEX multisig_account_member_member_address_index ON multisig_account_member (member_address);
+
+/* ---- Members of a multisig account that signed a transaction ---- */
+CREATE TABLE multisig_signer
+(
+    transaction_hash TEXT   NOT NULL,
+    multisig_address TEXT   NOT NULL REFERENCES multisig_account (address),
+    member_address   TEXT   NOT NULL REFERENCES account (address),
+    height           BIGINT NOT NULL,
+    PRIMARY KEY (transaction_hash, multisig_address, member_address)
+);
+CREATE INDEX multisig_signer_member_address_index ON multisig_signer (member_address);
+CREATE INDEX multisig_signer_height_index ON multisig_signer (height);
+
+/* ---- Activity of the accounts inside the messages ---- */
+
+/*
+ * Each account is stored once per message it is involved into, so that the same message can be indexed
+ * more than once (eg. when re-parsing or backfilling) without altering the aggregated stats below
+ */
+CREATE TABLE account_message
+(
+    address          TEXT                        NOT NULL REFERENCES account (address),
+    transaction_hash TEXT                        NOT NULL,
+    msg_index        BIGINT                      NOT NULL,
+    type             TEXT                        NOT NULL,
+    height           BIGINT                      NOT NULL,
+    timestamp        TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (address, transaction_hash, msg_index)
+);
+CREATE INDEX account_message_height_index ON account_message (height);
+
+CREATE TABLE account_activity
+(
+    address       TEXT                        NOT NULL PRIMARY KEY REFERENCES account (address),
+    first_height  BIGINT                      NOT NULL,
+    first_time    TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    last_height   BIGINT                      NOT NULL,
+    last_time     TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    message_count BIGINT                      NOT NULL DEFAULT 0
+);
+CREATE INDEX account_activity_first_height_index ON account_activity (first_height);
+CREATE INDEX account_activity_last_height_index ON account_activity (last_height);
+
+CREATE TABLE account_message_count
+(
+    address TEXT   NOT NULL REFERENCES account (address),
+    type    TEXT   NOT NULL,
+    count   BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (address, type)
+);
+
+/* ---- Fees paid by the accounts as the fee payer of a transaction ---- */
+CREATE TABLE account_fee_payment
+(
+    transaction_hash TEXT    NOT NULL,
+    address          TEXT    NOT NULL REFERENCES account (address),
+    denom            TEXT    NOT NULL,
+    amount           NUMERIC NOT NULL,
+    height           BIGINT  NOT NULL,
+    PRIMARY KEY (transaction_hash, denom)
+);
+CREATE INDEX account_fee_payment_address_index ON account_fee_payment (address);
+
+CREATE TABLE account_fees_paid
+(
+    address TEXT    NOT NULL REFERENCES account (address),
+    denom   TEXT    NOT NULL,
+    amount  NUMERIC NOT NULL,
+    PRIMARY KEY (address, denom)
 );
 
 /* ---- Moved from bank.sql for vesting account usage ---- */
@@ -17,10 +133,11 @@ CREATE TABLE vesting_account
     type                TEXT                            NOT NULL,
     address             TEXT                            NOT NULL REFERENCES account (address),
     original_vesting    COIN[]                          NOT NULL DEFAULT '{}',
-    end_time            TIMESTAMP WITHOUT TIME ZONE     NOT NULL,
+    end_time            TIMESTAMP WITHOUT TIME ZONE,
     start_time          TIMESTAMP WITHOUT TIME ZONE
 );
-/* ---- start_time can be empty on DelayedVestingAccount ---- */
+/* ---- start_time can be empty on DelayedVestingAccount and PermanentLockedAccount ---- */
+/* ---- end_time can be empty on PermanentLockedAccount ---- */
 
 CREATE UNIQUE INDEX vesting_account_address_idx ON vesting_account (address);
 
@@ -31,4 +148,27 @@ CREATE TABLE vesting_period
     period_order        BIGINT  NOT NULL,
     length              BIGINT  NOT NULL,
     amount              COIN[]  NOT NULL DEFAULT '{}'
-);
\ No newline at end of file
+);
+
+/* ---- Amount of tokens unlocked by all the vesting accounts each day ---- */
+CREATE TABLE vesting_unlock_calendar
+(
+    date   DATE    NOT NULL,
+    denom  TEXT    NOT NULL,
+    amount NUMERIC NOT NULL,
+    PRIMARY KEY (date, denom)
+);
+
+CREATE VIEW vesting_unlock_calendar_monthly AS
+SELECT date_trunc('month', date)::DATE AS month, denom, SUM(amount) AS amount
+FROM vesting_unlock_calendar
+GROUP BY month, denom;
+
+CREATE TABLE auth_params
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    params     JSONB   NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX auth_params_height_index ON auth_params (height);
@@ -7,4 +7,52 @@ CREATE TABLE supply
     height     BIGINT  NOT NULL,
     CHECK (one_row_id)
 );
-CREATE INDEX supply_height_index ON supply (height);
\ No newline at end of file
+CREATE INDEX supply_height_index ON supply (height);
+
+CREATE TABLE bank_params
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    params     JSONB   NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX bank_params_height_index ON bank_params (height);
+
+
+CREATE TABLE supply_history
+(
+    coins  COIN[] NOT NULL,
+    height BIGINT NOT NULL PRIMARY KEY
+);
+
+/* ---- SUPPLY CHANGES ---- */
+
+CREATE TABLE supply_change
+(
+    type             TEXT    NOT NULL,
+    origin           TEXT    NOT NULL,
+    address          TEXT    NOT NULL,
+    denom            TEXT    NOT NULL,
+    amount           NUMERIC NOT NULL,
+    transaction_hash TEXT,
+    event_index      BIGINT  NOT NULL,
+    height           BIGINT  NOT NULL,
+    CONSTRAINT unique_supply_change UNIQUE (height, event_index)
+);
+CREATE INDEX supply_change_height_index ON supply_change (height);
+CREATE INDEX supply_change_denom_index ON supply_change (denom);
+CREATE INDEX supply_change_origin_index ON supply_change (origin);
+
+CREATE TABLE supply_reconciliation
+(
+    date         DATE    NOT NULL,
+    denom        TEXT    NOT NULL,
+    start_height BIGINT  NOT NULL,
+    end_height   BIGINT  NOT NULL,
+    supply_delta NUMERIC NOT NULL,
+    minted       NUMERIC NOT NULL,
+    burned       NUMERIC NOT NULL,
+    unexplained  NUMERIC NOT NULL,
+    PRIMARY KEY (date, denom)
+);
+CREATE INDEX supply_reconciliation_unexplained_index ON supply_reconciliation (denom) WHERE unexplained <> 0;
@@ -42,3 +42,42 @@ CREATE TABLE average_block_time_from_genesis
     CHECK (one_row_id)
 );
 CREATE INDEX average_block_time_from_genesis_height_index ON average_block_time_from_genesis (height);
+
+CREATE TABLE consensus_params
+(
+    one_row_id BOOLEAN NOT NULL DEFAULT TRUE PRIMARY KEY,
+    params     JSONB   NOT NULL,
+    height     BIGINT  NOT NULL,
+    CHECK (one_row_id)
+);
+CREATE INDEX consensus_params_height_index ON consensus_params (height);
+
+/* ---- GAS ---- */
+
+/*
+ * utilization is the ratio between the gas used and the max_gas consensus param at the block height,
+ * and it's NULL when the block gas is unlimited.
+ */
+CREATE TABLE block_gas_usage
+(
+    height      BIGINT NOT NULL PRIMARY KEY,
+    gas_used    BIGINT NOT NULL,
+    gas_wanted  BIGINT NOT NULL,
+    max_gas     BIGINT,
+    utilization DOUBLE PRECISION
+);
+
+/*
+ * The gas price of a transaction is computed dividing its fee amount of the denom by its gas wanted.
+ */
+CREATE TABLE block_gas_price
+(
+    height           BIGINT  NOT NULL,
+    denom            TEXT    NOT NULL,
+    min_gas_price    NUMERIC NOT NULL,
+    median_gas_price NUMERIC NOT NULL,
+    p90_gas_price    NUMERIC NOT NULL,
+    total_fees       NUMERIC NOT NULL,
+    tx_count         INTEGER NOT NULL,
+    PRIMARY KEY (height, denom)
+);
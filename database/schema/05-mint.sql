@@ -17,4 +17,22 @@ CREATE TABLE inflation
     height     BIGINT  NOT NULL,
     CONSTRAINT one_row_uni CHECK (one_row_id)
 );
-CREATE INDEX inflation_height_index ON inflation (height);
\ No newline at end of file
+CREATE INDEX inflation_height_index ON inflation (height);
+
+
+/* ---- PROVISIONS ---- */
+
+CREATE TABLE annual_provisions_history
+(
+    value  DECIMAL NOT NULL,
+    height BIGINT  NOT NULL PRIMARY KEY
+);
+
+CREATE TABLE block_provision
+(
+    bonded_ratio      DECIMAL NOT NULL,
+    inflation         DECIMAL NOT NULL,
+    annual_provisions DECIMAL NOT NULL,
+    amount            NUMERIC NOT NULL,
+    height            BIGINT  NOT NULL PRIMARY KEY
+);
@@ -26,3 +26,93 @@ CREATE TABLE community_pool
     CONSTRAINT one_row_uni CHECK (one_row_id)
 );
 CREATE INDEX community_pool_height_index ON community_pool (height);
+
+CREATE TABLE community_pool_history
+(
+    coins  DEC_COIN[] NOT NULL,
+    height BIGINT     NOT NULL PRIMARY KEY
+);
+
+CREATE TABLE community_pool_deposit
+(
+    depositor_address TEXT   NOT NULL REFERENCES account (address),
+    amount            COIN[] NOT NULL DEFAULT '{}',
+    transaction_hash  TEXT   NOT NULL,
+    msg_index         BIGINT NOT NULL,
+    height            BIGINT NOT NULL,
+    CONSTRAINT unique_community_pool_deposit UNIQUE (transaction_hash, msg_index)
+);
+CREATE INDEX community_pool_deposit_depositor_address_index ON community_pool_deposit (depositor_address);
+CREATE INDEX community_pool_deposit_height_index ON community_pool_deposit (height);
+
+CREATE TABLE community_pool_tax
+(
+    amount DEC_COIN[] NOT NULL DEFAULT '{}',
+    height BIGINT     NOT NULL PRIMARY KEY
+);
+
+
+/* ---- WITHDRAWALS ---- */
+
+CREATE TABLE delegator_reward_withdrawal
+(
+    delegator_address TEXT   NOT NULL REFERENCES account (address),
+    validator_address TEXT   NOT NULL,
+    amount            COIN[] NOT NULL DEFAULT '{}',
+    transaction_hash  TEXT   NOT NULL,
+    msg_index         BIGINT NOT NULL,
+    height            BIGINT NOT NULL,
+    CONSTRAINT unique_delegator_reward_withdrawal UNIQUE (transaction_hash, msg_index, delegator_address, validator_address)
+);
+CREATE INDEX delegator_reward_withdrawal_delegator_address_index ON delegator_reward_withdrawal (delegator_address);
+CREATE INDEX delegator_reward_withdrawal_validator_address_index ON delegator_reward_withdrawal (validator_address);
+CREATE INDEX delegator_reward_withdrawal_height_index ON delegator_reward_withdrawal (height);
+
+CREATE TABLE validator_commission_withdrawal
+(
+    validator_address TEXT   NOT NULL,
+    amount            COIN[] NOT NULL DEFAULT '{}',
+    transaction_hash  TEXT   NOT NULL,
+    msg_index         BIGINT NOT NULL,
+    height            BIGINT NOT NULL,
+    CONSTRAINT unique_validator_commission_withdrawal UNIQUE (transaction_hash, msg_index, validator_address)
+);
+CREATE INDEX validator_commission_withdrawal_validator_address_index ON validator_commission_withdrawal (validator_address);
+CREATE INDEX validator_commission_withdrawal_height_index ON validator_commission_withdrawal (height);
+
+CREATE TABLE delegator_withdraw_address
+(
+    delegator_address TEXT   NOT NULL REFERENCES account (address) PRIMARY KEY,
+    withdraw_address  TEXT   NOT NULL,
+    height            BIGINT NOT NULL
+);
+CREATE INDEX delegator_withdraw_address_height_index ON delegator_withdraw_address (height);
+
+
+/* ---- REWARDS SNAPSHOTS ---- */
+
+CREATE TABLE delegator_reward_snapshot
+(
+    delegator_address TEXT                        NOT NULL REFERENCES account (address),
+    validator_address TEXT                        NOT NULL,
+    amount            DEC_COIN[]                  NOT NULL DEFAULT '{}',
+    height            BIGINT                      NOT NULL,
+    timestamp         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    CONSTRAINT unique_delegator_reward_snapshot UNIQUE (delegator_address, validator_address, height)
+);
+CREATE INDEX delegator_reward_snapshot_delegator_address_index ON delegator_reward_snapshot (delegator_address);
+CREATE INDEX delegator_reward_snapshot_timestamp_index ON delegator_reward_snapshot (timestamp);
+
+
+/* ---- VALIDATORS REWARDS ---- */
+
+CREATE TABLE validator_rewards_history
+(
+    validator_address   TEXT                        NOT NULL REFERENCES validator (consensus_address),
+    outstanding_rewards DEC_COIN[]                  NOT NULL DEFAULT '{}',
+    commission          DEC_COIN[]                  NOT NULL DEFAULT '{}',
+    height              BIGINT                      NOT NULL,
+    timestamp           TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (validator_address, height)
+);
+CREATE INDEX validator_rewards_history_timestamp_index ON validator_rewards_history (timestamp);
@@ -36,6 +36,19 @@ CREATE INDEX proposal_deposit_proposal_id_index ON proposal_deposit (proposal_id
 CREATE INDEX proposal_deposit_depositor_address_index ON proposal_deposit (depositor_address);
 CREATE INDEX proposal_deposit_depositor_height_index ON proposal_deposit (height);
 
+/* ---- outcome is either refunded or burned ---- */
+CREATE TABLE proposal_deposit_outcome
+(
+    proposal_id       INTEGER NOT NULL REFERENCES proposal (id),
+    depositor_address TEXT    NOT NULL REFERENCES account (address),
+    amount            COIN[]  NOT NULL DEFAULT '{}',
+    outcome           TEXT    NOT NULL,
+    height            BIGINT  NOT NULL,
+    CONSTRAINT unique_deposit_outcome UNIQUE (proposal_id, depositor_address)
+);
+CREATE INDEX proposal_deposit_outcome_proposal_id_index ON proposal_deposit_outcome (proposal_id);
+CREATE INDEX proposal_deposit_outcome_depositor_address_index ON proposal_deposit_outcome (depositor_address);
+
 CREATE TABLE proposal_vote
 (
     proposal_id   INTEGER NOT NULL REFERENCES proposal (id),
@@ -63,6 +76,34 @@ CREATE TABLE proposal_tally_result
 CREATE INDEX proposal_tally_result_proposal_id_index ON proposal_tally_result (proposal_id);
 CREATE INDEX proposal_tally_result_height_index ON proposal_tally_result (height);
 
+/*
+ * This holds the status of the tally of a proposal evaluated against the current gov params.
+ * yes_ratio is computed over the non-abstain votes, while veto_ratio over all the votes.
+ */
+CREATE TABLE proposal_tally_status
+(
+    proposal_id       INTEGER REFERENCES proposal (id) PRIMARY KEY,
+    turnout           DECIMAL NOT NULL,
+    yes_ratio         DECIMAL NOT NULL,
+    veto_ratio        DECIMAL NOT NULL,
+    quorum_reached    BOOLEAN NOT NULL,
+    projected_outcome TEXT    NOT NULL,
+    height            BIGINT  NOT NULL
+);
+CREATE INDEX proposal_tally_status_height_index ON proposal_tally_status (height);
+
+CREATE TABLE proposal_execution_result
+(
+    proposal_id INTEGER NOT NULL REFERENCES proposal (id) PRIMARY KEY,
+    success     BOOLEAN NOT NULL,
+    log         TEXT,
+    height      BIGINT  NOT NULL
+);
+CREATE INDEX proposal_execution_result_height_index ON proposal_execution_result (height);
+COMMENT ON COLUMN proposal_execution_result.log IS
+    'Execution log of the proposal, taken from the proposal_log event attribute. '
+    'It is always NULL on chains using x/gov v0.47 or earlier, since they do not emit such attribute';
+
 CREATE TABLE proposal_staking_pool_snapshot
 (
     proposal_id       INTEGER REFERENCES proposal (id) PRIMARY KEY,
@@ -85,4 +126,19 @@ CREATE TABLE proposal_validator_status_snapshot
     CONSTRAINT unique_validator_status_snapshot UNIQUE (proposal_id, validator_address)
 );
 CREATE INDEX proposal_validator_status_snapshot_proposal_id_index ON proposal_validator_status_snapshot (proposal_id);
-CREATE INDEX proposal_validator_status_snapshot_validator_address_index ON proposal_validator_status_snapshot (validator_address);
\ No newline at end of file
+CREATE INDEX proposal_validator_status_snapshot_validator_address_index ON proposal_validator_status_snapshot (validator_address);
+
+
+/* The community pool spends are stored here as they reference the proposals that executed them */
+CREATE TABLE community_pool_spend
+(
+    proposal_id       INTEGER NOT NULL REFERENCES proposal (id),
+    msg_index         BIGINT  NOT NULL,
+    recipient_address TEXT    NOT NULL,
+    amount            COIN[]  NOT NULL DEFAULT '{}',
+    height            BIGINT  NOT NULL,
+    CONSTRAINT unique_community_pool_spend UNIQUE (proposal_id, msg_index)
+);
+CREATE INDEX community_pool_spend_proposal_id_index ON community_pool_spend (proposal_id);
+CREATE INDEX community_pool_spend_recipient_address_index ON community_pool_spend (recipient_address);
+CREATE INDEX community_pool_spend_height_index ON community_pool_spend (height);
@@ -4,7 +4,27 @@ CREATE TABLE fee_grant_allowance
     grantee_address    TEXT        NOT NULL REFERENCES account (address),
     granter_address    TEXT        NOT NULL REFERENCES account (address),
     allowance          JSONB       NOT NULL DEFAULT '{}'::JSONB,
+    expiration         TIMESTAMP WITHOUT TIME ZONE,
+    expired            BOOLEAN     NOT NULL DEFAULT FALSE,
     height             BIGINT      NOT NULL,
     CONSTRAINT unique_fee_grant_allowance UNIQUE(grantee_address, granter_address) 
 );
 CREATE INDEX fee_grant_allowance_height_index ON fee_grant_allowance (height);
+CREATE INDEX fee_grant_allowance_expiration_index ON fee_grant_allowance (expiration) WHERE expired = FALSE;
+
+/* Allowances that have not expired yet, as the chain prunes the expired ones lazily */
+CREATE VIEW active_fee_grant_allowance AS
+SELECT id, grantee_address, granter_address, allowance, expiration, height
+FROM fee_grant_allowance
+WHERE expired = FALSE;
+
+CREATE TABLE fee_grant_usage
+(
+    transaction_hash TEXT   NOT NULL PRIMARY KEY,
+    granter_address  TEXT   NOT NULL REFERENCES account (address),
+    grantee_address  TEXT   NOT NULL REFERENCES account (address),
+    fee              COIN[] NOT NULL DEFAULT '{}',
+    height           BIGINT NOT NULL
+);
+CREATE INDEX fee_grant_usage_granter_grantee_index ON fee_grant_usage (granter_address, grantee_address);
+CREATE INDEX fee_grant_usage_height_index ON fee_grant_usage (height);
@@ -0,0 +1,18 @@
+/* ---- MODULE PARAMS HISTORY ---- */
+
+/*
+ * This holds every version of the params of each module, while the <module>_params tables
+ * only contain the most recent ones.
+ * proposal_id is set when the params have been changed by a governance proposal.
+ */
+CREATE TABLE module_params_history
+(
+    module      TEXT    NOT NULL,
+    params      JSONB   NOT NULL,
+    height      BIGINT  NOT NULL,
+    proposal_id INTEGER REFERENCES proposal (id),
+    CONSTRAINT unique_module_params_history UNIQUE (module, height)
+);
+CREATE INDEX module_params_history_module_index ON module_params_history (module);
+CREATE INDEX module_params_history_height_index ON module_params_history (height);
+CREATE INDEX module_params_history_proposal_id_index ON module_params_history (proposal_id);
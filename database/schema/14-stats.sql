@@ -0,0 +1,116 @@
+/* ---- CHAIN STATS ROLLUPS ---- */
+
+/*
+ * Each row contains the stats of the blocks whose timestamp is inside the [start_time, start_time + period) range.
+ * period is either "hour" or "day", while start_time is expressed in UTC.
+ * unique_signers counts the distinct public keys found inside the transactions signer infos.
+ * new_accounts counts the accounts whose first activity happened inside the period.
+ * gas_utilization is the average utilization of the blocks gas, as stored inside the block_gas_usage table.
+ */
+CREATE TABLE chain_stats
+(
+    period              TEXT                        NOT NULL,
+    start_time          TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    from_height         BIGINT,
+    to_height           BIGINT,
+    tx_count            BIGINT                      NOT NULL DEFAULT 0,
+    successful_tx_count BIGINT                      NOT NULL DEFAULT 0,
+    success_rate        DOUBLE PRECISION,
+    unique_signers      BIGINT                      NOT NULL DEFAULT 0,
+    new_accounts        BIGINT                      NOT NULL DEFAULT 0,
+    gas_used            NUMERIC                     NOT NULL DEFAULT 0,
+    gas_wanted          NUMERIC                     NOT NULL DEFAULT 0,
+    gas_utilization     DOUBLE PRECISION,
+    PRIMARY KEY (period, start_time)
+);
+CREATE INDEX chain_stats_start_time_index ON chain_stats (start_time);
+
+CREATE TABLE chain_stats_fee
+(
+    period           TEXT                        NOT NULL,
+    start_time       TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    denom            TEXT                        NOT NULL,
+    amount           NUMERIC                     NOT NULL,
+    min_gas_price    NUMERIC,
+    median_gas_price NUMERIC,
+    p90_gas_price    NUMERIC,
+    PRIMARY KEY (period, start_time, denom)
+);
+
+CREATE TABLE chain_stats_message
+(
+    period     TEXT                        NOT NULL,
+    start_time TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    type       TEXT                        NOT NULL,
+    count      BIGINT                      NOT NULL,
+    PRIMARY KEY (period, start_time, type)
+);
+
+-- Allows to compute the messages stats of a single period without scanning the whole table
+CREATE INDEX message_height_index ON message (height);
+
+/* ---- MESSAGE TYPES ---- */
+
+/*
+ * registered tells whether the message type is registered inside the application interface registry,
+ * while package contains the protobuf package of the message type.
+ * The usage is periodically updated using the messages of the latest days, while count is the sum of the daily
+ * chain_stats_message rollups. Days that have not been rolled up yet can be computed using the "parse stats" commands.
+ */
+CREATE TABLE message_type
+(
+    type              TEXT    NOT NULL PRIMARY KEY,
+    package           TEXT    NOT NULL,
+    registered        BOOLEAN NOT NULL DEFAULT FALSE,
+    first_seen_height BIGINT,
+    last_seen_height  BIGINT,
+    count             BIGINT  NOT NULL DEFAULT 0
+);
+CREATE INDEX message_type_package_index ON message_type (package);
+
+CREATE VIEW message_type_daily AS
+SELECT type, start_time::DATE AS day, count
+FROM chain_stats_message
+WHERE period = 'day';
+
+/* ---- FAILED TRANSACTIONS ---- */
+
+/*
+ * error contains the description of the error registered with the given codespace and code,
+ * or "unknown" when no such error is registered inside the application.
+ * message_types contains the distinct types of the messages included inside the transaction.
+ */
+CREATE TABLE transaction_error
+(
+    transaction_hash TEXT    NOT NULL PRIMARY KEY,
+    height           BIGINT  NOT NULL,
+    codespace        TEXT    NOT NULL,
+    code             INTEGER NOT NULL,
+    error            TEXT    NOT NULL,
+    message_types    TEXT[]  NOT NULL DEFAULT '{}'
+);
+CREATE INDEX transaction_error_height_index ON transaction_error (height);
+
+/*
+ * Number of failed transactions grouped by day, message type and failure reason.
+ * Transactions containing more than one message are counted once for each of their messages types.
+ */
+CREATE VIEW transaction_error_daily AS
+SELECT date_trunc('day', block.timestamp) AS day,
+       message_type,
+       codespace,
+       code,
+       error,
+       COUNT(*)                           AS count
+FROM transaction_error
+    JOIN block ON block.height = transaction_error.height
+    CROSS JOIN LATERAL unnest(transaction_error.message_types) AS message_type
+GROUP BY day, message_type, codespace, code, error;
+
+/*
+ * Number of failed transactions grouped by message type and failure reason.
+ */
+CREATE VIEW transaction_error_total AS
+SELECT message_type, codespace, code, error, SUM(count) AS count
+FROM transaction_error_daily
+GROUP BY message_type, codespace, code, error;
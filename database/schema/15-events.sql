@@ -0,0 +1,56 @@
+/* ---- EVENTS ---- */
+
+/*
+ * source is either "tx", "begin_block" or "end_block".
+ * transaction_hash and msg_index are only set for transaction events, and msg_index is NULL for the transaction
+ * events that have not been emitted by any message (eg. fees payment, or all the events of failed transactions).
+ * index represents the position of the event among the ones having the same source.
+ */
+CREATE TABLE event
+(
+    id               BIGSERIAL NOT NULL PRIMARY KEY,
+    height           BIGINT    NOT NULL,
+    source           TEXT      NOT NULL,
+    transaction_hash TEXT,
+    msg_index        INTEGER,
+    index            INTEGER   NOT NULL,
+    type             TEXT      NOT NULL
+);
+CREATE INDEX event_height_index ON event (height);
+CREATE INDEX event_transaction_hash_index ON event (transaction_hash);
+CREATE INDEX event_type_index ON event (type);
+
+CREATE TABLE event_attribute
+(
+    event_id BIGINT  NOT NULL REFERENCES event (id) ON DELETE CASCADE,
+    index    INTEGER NOT NULL,
+    key      TEXT    NOT NULL,
+    value    TEXT    NOT NULL,
+    PRIMARY KEY (event_id, index)
+);
+-- Values can be arbitrarily long (eg. JSON encoded data), so they are indexed using their hash
+CREATE INDEX event_attribute_key_value_index ON event_attribute (key, md5(value));
+
+/**
+ * This function is used to find all the events having the given type and containing an attribute
+ * with the given key and value. When attribute_value is NULL, any value of the attribute is matched.
+ */
+CREATE FUNCTION events_by_attribute(
+    event_type TEXT,
+    attribute_key TEXT,
+    attribute_value TEXT = NULL,
+    "limit" BIGINT = 100,
+    "offset" BIGINT = 0)
+    RETURNS SETOF event AS
+$$
+SELECT * FROM event
+WHERE type = event_type
+  AND EXISTS(
+    SELECT 1 FROM event_attribute
+    WHERE event_attribute.event_id = event.id
+      AND event_attribute.key = attribute_key
+      AND (attribute_value IS NULL OR (md5(event_attribute.value) = md5(attribute_value)
+                                       AND event_attribute.value = attribute_value))
+  )
+ORDER BY height DESC, id DESC LIMIT "limit" OFFSET "offset"
+$$ LANGUAGE sql STABLE;
@@ -0,0 +1,24 @@
+/* ---- FULL-TEXT SEARCH ---- */
+
+/*
+ * The following columns are generated by the database itself each time a row is inserted or updated.
+ * Memos and monikers are indexed using the "simple" configuration since they are not necessarily english words,
+ * while the proposals and validators details use the "english" one.
+ */
+ALTER TABLE transaction
+    ADD COLUMN memo_search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(memo, ''))) STORED;
+CREATE INDEX transaction_memo_search_index ON transaction USING GIN (memo_search);
+
+ALTER TABLE validator_description
+    ADD COLUMN search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', COALESCE(moniker, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(details, '')), 'B')
+    ) STORED;
+CREATE INDEX validator_description_search_index ON validator_description USING GIN (search);
+
+ALTER TABLE proposal
+    ADD COLUMN search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', description), 'B')
+    ) STORED;
+CREATE INDEX proposal_search_index ON proposal USING GIN (search);
@@ -0,0 +1,21 @@
+CREATE TABLE authz_grant
+(
+    id                  SERIAL  NOT NULL PRIMARY KEY,
+    granter_address     TEXT    NOT NULL REFERENCES account (address),
+    grantee_address     TEXT    NOT NULL REFERENCES account (address),
+    msg_type_url        TEXT    NOT NULL,
+    authorization_value JSONB   NOT NULL DEFAULT '{}'::JSONB,
+    expiration          TIMESTAMP WITHOUT TIME ZONE,
+    expired             BOOLEAN NOT NULL DEFAULT FALSE,
+    height              BIGINT  NOT NULL,
+    CONSTRAINT unique_authz_grant UNIQUE (granter_address, grantee_address, msg_type_url)
+);
+CREATE INDEX authz_grant_grantee_index ON authz_grant (grantee_address);
+CREATE INDEX authz_grant_height_index ON authz_grant (height);
+CREATE INDEX authz_grant_expiration_index ON authz_grant (expiration) WHERE expired = FALSE;
+
+/* Grants that have not expired yet, as the chain prunes the expired ones only at the beginning of the blocks */
+CREATE VIEW active_authz_grant AS
+SELECT id, granter_address, grantee_address, msg_type_url, authorization_value, expiration, height
+FROM authz_grant
+WHERE expired = FALSE;
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/forbole/bdjuno/v4/types"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+)
+
+// SearchHeight returns the block having the given height and the proposal having the given id, if any
+func (db *Db) SearchHeight(height int64) ([]types.SearchResult, error) {
+	stmt := fmt.Sprintf(`
+SELECT '%s' AS type, height::TEXT AS id, NULL AS title, 1 AS rank FROM block WHERE height = $1
+UNION ALL
+SELECT '%s' AS type, id::TEXT AS id, title, 1 AS rank FROM proposal WHERE id = $1`,
+		types.SearchResultBlock, types.SearchResultProposal)
+
+	return db.search(stmt, height)
+}
+
+// SearchHash returns the transaction and the block having the given hash, if any
+func (db *Db) SearchHash(hash string) ([]types.SearchResult, error) {
+	stmt := fmt.Sprintf(`
+SELECT DISTINCT '%s' AS type, hash AS id, memo AS title, 1 AS rank FROM transaction WHERE hash = upper($1)
+UNION ALL
+SELECT '%s' AS type, height::TEXT AS id, hash AS title, 1 AS rank FROM block WHERE hash = upper($1)`,
+		types.SearchResultTransaction, types.SearchResultBlock)
+
+	return db.search(stmt, hash)
+}
+
+// SearchAddress returns the account and the validator having the given address, if any.
+// Validators can be found using either their operator or consensus address
+func (db *Db) SearchAddress(address string) ([]types.SearchResult, error) {
+	stmt := fmt.Sprintf(`
+SELECT '%s' AS type, address AS id, NULL AS title, 1 AS rank FROM account WHERE address = $1
+UNION ALL
+SELECT '%s' AS type, validator_info.operator_address AS id, validator_description.moniker AS title, 1 AS rank
+FROM validator_info
+    LEFT JOIN validator_description ON validator_description.validator_address = validator_info.consensus_address
+WHERE validator_info.operator_address = $1 OR validator_info.consensus_address = $1`,
+		types.SearchResultAccount, types.SearchResultValidator)
+
+	return db.search(stmt, address)
+}
+
+// maxTransactionSearchCandidates represents the maximum number of transactions matching a text search that are ranked.
+// Common words might match a huge number of memos, so only the most recent ones are considered
+const maxTransactionSearchCandidates = 1000
+
+// SearchText returns the proposals, validators and transactions whose text matches the given query,
+// sorted by rank. At most limit results are returned, and only the most recent matching transactions are ranked
+func (db *Db) SearchText(query string, limit int) ([]types.SearchResult, error) {
+	stmt := fmt.Sprintf(`
+SELECT * FROM (
+    SELECT '%s' AS type, id::TEXT AS id, title, ts_rank(search, query) AS rank
+    FROM proposal, websearch_to_tsquery('english', $1) AS query
+    WHERE search @@ query
+    ORDER BY rank DESC LIMIT $2
+) AS proposals
+UNION ALL
+SELECT * FROM (
+    SELECT '%s' AS type, validator_info.operator_address AS id, moniker AS title, ts_rank(search, query) AS rank
+    FROM validator_description
+        JOIN validator_info ON validator_info.consensus_address = validator_description.validator_address,
+        (SELECT websearch_to_tsquery('simple', $1) || websearch_to_tsquery('english', $1) AS query) AS queries
+    WHERE search @@ query
+    ORDER BY rank DESC LIMIT $2
+) AS validators
+UNION ALL
+SELECT * FROM (
+    SELECT '%s' AS type, hash AS id, memo AS title, ts_rank(memo_search, query) AS rank
+    FROM (
+        SELECT hash, memo, memo_search, query
+        FROM transaction, websearch_to_tsquery('simple', $1) AS query
+        WHERE memo_search @@ query
+        ORDER BY height DESC LIMIT %d
+    ) AS candidates
+    ORDER BY rank DESC LIMIT $2
+) AS transactions
+ORDER BY rank DESC LIMIT $2`,
+		types.SearchResultProposal, types.SearchResultValidator, types.SearchResultTransaction,
+		maxTransactionSearchCandidates)
+
+	return db.search(stmt, query, limit)
+}
+
+func (db *Db) search(stmt string, args ...interface{}) ([]types.SearchResult, error) {
+	var rows []dbtypes.SearchResultRow
+	err := db.Sqlx.Select(&rows, stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error while searching: %s", err)
+	}
+
+	results := make([]types.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = types.NewSearchResult(row.Type, row.ID, row.Title.String, row.Rank)
+	}
+	return results, nil
+}
@@ -0,0 +1,39 @@
+package database_test
+
+import (
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SearchText() {
+	suite.getProposalRow(1)
+	suite.getProposalRow(2)
+
+	results, err := suite.database.SearchText("descriptions", 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 2)
+	suite.Require().Equal(types.SearchResultProposal, results[0].Type)
+
+	results, err = suite.database.SearchText("unknown", 10)
+	suite.Require().NoError(err)
+	suite.Require().Empty(results)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SearchHeight() {
+	suite.getProposalRow(1)
+
+	results, err := suite.database.SearchHeight(1)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SearchResult{
+		types.NewSearchResult(types.SearchResultProposal, "1", "Proposal 1", 1),
+	}, results)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SearchAddress() {
+	suite.getAccount("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+
+	results, err := suite.database.SearchAddress("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SearchResult{
+		types.NewSearchResult(types.SearchResultAccount, "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", "", 1),
+	}, results)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -69,5 +71,5 @@ WHERE slashing_params.height <= excluded.height`
 		return fmt.Errorf("error while storing slashing params: %s", err)
 	}
 
-	return nil
+	return db.saveModuleParamsHistory(slashingtypes.ModuleName, paramsBz, params.Height)
 }
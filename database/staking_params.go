@@ -30,7 +30,7 @@ WHERE staking_params.height <= excluded.height`
 		return fmt.Errorf("error while storing staking params: %s", err)
 	}
 
-	return nil
+	return db.saveModuleParamsHistory(stakingtypes.ModuleName, paramsBz, params.Height)
 }
 
 // GetStakingParams returns the types.StakingParams instance containing the current params
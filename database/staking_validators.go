@@ -268,7 +268,7 @@ WHERE validator_description.height <= excluded.height`
 // If no description could be found, returns false instead
 func (db *Db) getValidatorDescription(address sdk.ConsAddress) (*types.ValidatorDescription, bool) {
 	var result []dbtypes.ValidatorDescriptionRow
-	stmt := `SELECT * FROM validator_description WHERE validator_description.validator_address = $1`
+	stmt := `SELECT validator_address, moniker, identity, avatar_url, website, security_contact, details, height FROM validator_description WHERE validator_description.validator_address = $1`
 
 	err := db.Sqlx.Select(&result, stmt, address.String())
 	if err != nil {
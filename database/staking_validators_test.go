@@ -331,7 +331,7 @@ func (suite *DbTestSuite) TestSaveValidatorDescription() {
 	}
 
 	var rows []dbtypes.ValidatorDescriptionRow
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM validator_description")
+	err = suite.database.Sqlx.Select(&rows, "SELECT validator_address, moniker, identity, avatar_url, website, security_contact, details, height FROM validator_description")
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, len(expected))
 
@@ -353,7 +353,7 @@ func (suite *DbTestSuite) TestSaveValidatorDescription() {
 
 	// Verify the data
 	rows = []dbtypes.ValidatorDescriptionRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM validator_description")
+	err = suite.database.Sqlx.Select(&rows, "SELECT validator_address, moniker, identity, avatar_url, website, security_contact, details, height FROM validator_description")
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, len(expected))
 
@@ -388,7 +388,7 @@ func (suite *DbTestSuite) TestSaveValidatorDescription() {
 	}
 
 	rows = []dbtypes.ValidatorDescriptionRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM validator_description")
+	err = suite.database.Sqlx.Select(&rows, "SELECT validator_address, moniker, identity, avatar_url, website, security_contact, details, height FROM validator_description")
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, len(expected))
 
@@ -423,7 +423,7 @@ func (suite *DbTestSuite) TestSaveValidatorDescription() {
 	}
 
 	rows = []dbtypes.ValidatorDescriptionRow{}
-	err = suite.database.Sqlx.Select(&rows, "SELECT * FROM validator_description")
+	err = suite.database.Sqlx.Select(&rows, "SELECT validator_address, moniker, identity, avatar_url, website, security_contact, details, height FROM validator_description")
 	suite.Require().NoError(err)
 	suite.Require().Len(rows, len(expected))
 
@@ -0,0 +1,238 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// SaveChainStats computes and stores the chain stats of the given period starting at the given time,
+// replacing the ones that might have been computed before.
+// Everything is stored within a single database transaction so that readers never see the stats partially replaced
+func (db *Db) SaveChainStats(period types.StatsPeriod, start time.Time) error {
+	end := period.Next(start)
+
+	var fromHeight, toHeight *int64
+	err := db.SQL.QueryRow(
+		`SELECT MIN(height), MAX(height) FROM block WHERE timestamp >= $1 AND timestamp < $2`, start, end,
+	).Scan(&fromHeight, &toHeight)
+	if err != nil {
+		return fmt.Errorf("error while getting %s stats heights: %s", period, err)
+	}
+
+	// Use an empty heights range when there are no blocks, so that the stats are all set to zero
+	from, to := int64(1), int64(0)
+	if fromHeight != nil && toHeight != nil {
+		from, to = *fromHeight, *toHeight
+	}
+
+	stmt := `
+INSERT INTO chain_stats (period, start_time, from_height, to_height, tx_count, successful_tx_count, success_rate, 
+                         unique_signers, new_accounts, gas_used, gas_wanted, gas_utilization)
+SELECT $1::TEXT, $2::TIMESTAMP, $3::BIGINT, $4::BIGINT, txs.tx_count, txs.successful_tx_count, 
+       CASE WHEN txs.tx_count = 0 THEN NULL ELSE txs.successful_tx_count::DOUBLE PRECISION / txs.tx_count END,
+       signers.count, accounts.count, txs.gas_used, txs.gas_wanted, usage.utilization
+FROM (
+    SELECT COUNT(*) AS tx_count, 
+           COUNT(*) FILTER (WHERE success) AS successful_tx_count, 
+           COALESCE(SUM(gas_used), 0) AS gas_used, 
+           COALESCE(SUM(gas_wanted), 0) AS gas_wanted
+    FROM transaction WHERE height >= $5 AND height <= $6
+) AS txs, (
+    SELECT COUNT(DISTINCT signer_info -> 'public_key') AS count
+    FROM transaction
+        CROSS JOIN LATERAL jsonb_array_elements(signer_infos) AS signer_info
+    WHERE height >= $5 AND height <= $6 AND jsonb_typeof(signer_info -> 'public_key') = 'object'
+) AS signers, (
+    SELECT COUNT(*) AS count FROM account_activity WHERE first_height >= $5 AND first_height <= $6
+) AS accounts, (
+    SELECT AVG(utilization) AS utilization FROM block_gas_usage WHERE height >= $5 AND height <= $6
+) AS usage
+ON CONFLICT (period, start_time) DO UPDATE 
+    SET from_height = excluded.from_height,
+        to_height = excluded.to_height,
+        tx_count = excluded.tx_count,
+        successful_tx_count = excluded.successful_tx_count,
+        success_rate = excluded.success_rate,
+        unique_signers = excluded.unique_signers,
+        new_accounts = excluded.new_accounts,
+        gas_used = excluded.gas_used,
+        gas_wanted = excluded.gas_wanted,
+        gas_utilization = excluded.gas_utilization`
+
+	tx, err := db.Sqlx.Beginx()
+	if err != nil {
+		return fmt.Errorf("error while beginning %s stats transaction: %s", period, err)
+	}
+
+	_, err = tx.Exec(stmt, period, start, fromHeight, toHeight, from, to)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while storing %s stats: %s", period, err)
+	}
+
+	_, err = tx.Exec(`DELETE FROM chain_stats_fee WHERE period = $1 AND start_time = $2`, period, start)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while deleting %s fees stats: %s", period, err)
+	}
+
+	stmt = `
+INSERT INTO chain_stats_fee (period, start_time, denom, amount, min_gas_price, median_gas_price, p90_gas_price)
+SELECT $1::TEXT, $2::TIMESTAMP, coin ->> 'denom', SUM((coin ->> 'amount')::NUMERIC),
+       MIN((coin ->> 'amount')::NUMERIC / NULLIF(gas_wanted, 0)),
+       percentile_cont(0.5) WITHIN GROUP (ORDER BY (coin ->> 'amount')::NUMERIC / NULLIF(gas_wanted, 0)),
+       percentile_cont(0.9) WITHIN GROUP (ORDER BY (coin ->> 'amount')::NUMERIC / NULLIF(gas_wanted, 0))
+FROM transaction
+    CROSS JOIN LATERAL jsonb_array_elements(fee -> 'amount') AS coin
+WHERE height >= $3 AND height <= $4 AND jsonb_typeof(fee -> 'amount') = 'array'
+GROUP BY coin ->> 'denom'`
+
+	_, err = tx.Exec(stmt, period, start, from, to)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while storing %s fees stats: %s", period, err)
+	}
+
+	_, err = tx.Exec(`DELETE FROM chain_stats_message WHERE period = $1 AND start_time = $2`, period, start)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while deleting %s messages stats: %s", period, err)
+	}
+
+	stmt = `
+INSERT INTO chain_stats_message (period, start_time, type, count)
+SELECT $1::TEXT, $2::TIMESTAMP, type, COUNT(*) FROM message WHERE height >= $3 AND height <= $4 GROUP BY type`
+
+	_, err = tx.Exec(stmt, period, start, from, to)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error while storing %s messages stats: %s", period, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error while committing %s stats transaction: %s", period, err)
+	}
+
+	return nil
+}
+
+// SaveTransactionError stores the given transaction error
+func (db *Db) SaveTransactionError(txError types.TransactionError) error {
+	stmt := `
+INSERT INTO transaction_error (transaction_hash, height, codespace, code, error, message_types) 
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (transaction_hash) DO UPDATE 
+    SET height = excluded.height,
+        codespace = excluded.codespace,
+        code = excluded.code,
+        error = excluded.error,
+        message_types = excluded.message_types`
+
+	_, err := db.SQL.Exec(stmt,
+		txError.TxHash, txError.Height, txError.Codespace, txError.Code, txError.Error, pq.Array(txError.MessageTypes))
+	if err != nil {
+		return fmt.Errorf("error while storing transaction error: %s", err)
+	}
+
+	return nil
+}
+
+// SaveRegisteredMessageTypes stores the given message types, marking them as registered
+func (db *Db) SaveRegisteredMessageTypes(msgTypes []types.MessageType) error {
+	if len(msgTypes) == 0 {
+		return nil
+	}
+
+	stmt := `INSERT INTO message_type (type, package, registered) VALUES `
+	var params []interface{}
+
+	for i, msgType := range msgTypes {
+		mi := i * 2
+		stmt += fmt.Sprintf("($%d,$%d,true),", mi+1, mi+2)
+		params = append(params, msgType.Type, msgType.Package)
+	}
+
+	stmt = stmt[:len(stmt)-1] // Remove trailing ","
+	stmt += `
+ON CONFLICT (type) DO UPDATE 
+    SET package = excluded.package,
+        registered = excluded.registered`
+
+	_, err := db.SQL.Exec(stmt, params...)
+	if err != nil {
+		return fmt.Errorf("error while storing registered message types: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateMessageTypesUsage updates the usage of the message types using the messages stored since the beginning of
+// the daily rollup starting at the given time, and the counts of all the daily rollups.
+// Since the usage is computed from the stored data, this can be called any number of times
+func (db *Db) UpdateMessageTypesUsage(since time.Time) error {
+	stmt := `
+WITH recent AS (
+    SELECT type, MIN(height) AS first_seen_height, MAX(height) AS last_seen_height
+    FROM message
+    WHERE height >= (SELECT from_height FROM chain_stats WHERE period = $1 AND start_time = $2)
+    GROUP BY type
+), totals AS (
+    SELECT type, SUM(count) AS count
+    FROM chain_stats_message
+    WHERE period = $1
+    GROUP BY type
+)
+INSERT INTO message_type (type, package, first_seen_height, last_seen_height, count)
+SELECT COALESCE(recent.type, totals.type), 
+       COALESCE(substring(COALESCE(recent.type, totals.type) FROM '^(.*)\.[^.]*$'), ''), 
+       recent.first_seen_height, recent.last_seen_height, COALESCE(totals.count, 0)
+FROM recent
+    FULL OUTER JOIN totals ON totals.type = recent.type
+ON CONFLICT (type) DO UPDATE 
+    SET first_seen_height = LEAST(message_type.first_seen_height, excluded.first_seen_height),
+        last_seen_height = GREATEST(message_type.last_seen_height, excluded.last_seen_height),
+        count = excluded.count`
+
+	_, err := db.SQL.Exec(stmt, types.StatsPeriodDay, since)
+	if err != nil {
+		return fmt.Errorf("error while updating message types usage: %s", err)
+	}
+
+	return nil
+}
+
+// RebuildMessageTypesUsage computes again the usage of all the message types scanning all the stored messages.
+// Since it reads the whole message table, it should only be used to fix the usage computed periodically
+func (db *Db) RebuildMessageTypesUsage() error {
+	// Message types that are no longer used (eg. after pruning) are reset within the same statement,
+	// so that readers never see a partially computed usage
+	stmt := `
+WITH usage AS (
+    SELECT type, MIN(height) AS first_seen_height, MAX(height) AS last_seen_height, COUNT(*) AS count
+    FROM message
+    GROUP BY type
+), unused AS (
+    UPDATE message_type 
+    SET first_seen_height = NULL, last_seen_height = NULL, count = 0
+    WHERE type NOT IN (SELECT type FROM usage)
+)
+INSERT INTO message_type (type, package, first_seen_height, last_seen_height, count)
+SELECT type, COALESCE(substring(type FROM '^(.*)\.[^.]*$'), ''), first_seen_height, last_seen_height, count
+FROM usage
+ON CONFLICT (type) DO UPDATE 
+    SET first_seen_height = excluded.first_seen_height,
+        last_seen_height = excluded.last_seen_height,
+        count = excluded.count`
+
+	_, err := db.SQL.Exec(stmt)
+	if err != nil {
+		return fmt.Errorf("error while rebuilding message types usage: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package database_test
+
+import (
+	"time"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveChainStats() {
+	timestamp := time.Date(2020, 1, 1, 15, 30, 00, 000, time.UTC)
+
+	_, err := suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
+	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
+	VALUES (10, 'hash10', 2, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $1),
+	       (11, 'hash11', 0, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $2)`,
+		timestamp, timestamp.Add(time.Hour))
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`CREATE TABLE transaction_0 PARTITION OF transaction FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`CREATE TABLE message_0 PARTITION OF message FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO transaction (hash, height, success, signatures, signer_infos, fee, gas_wanted, gas_used) 
+VALUES ('tx1', 10, true, '{}', '[{"public_key": {"key": "A"}}]', '{"amount": [{"denom": "uatom", "amount": "100"}]}', 200, 100),
+       ('tx2', 10, false, '{}', '[{"public_key": {"key": "A"}}]', '{"amount": [{"denom": "uatom", "amount": "50"}]}', 200, 150)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO message (transaction_hash, index, type, value, involved_accounts_addresses, height) 
+VALUES ('tx1', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 10),
+       ('tx2', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 10)`)
+	suite.Require().NoError(err)
+
+	err = suite.database.SaveAccountsActivity([]types.AccountActivity{
+		types.NewAccountActivity(
+			"cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", "tx1", 0, "cosmos.bank.v1beta1.MsgSend", 10, timestamp,
+		),
+	})
+	suite.Require().NoError(err)
+
+	// Compute the hourly stats twice to make sure they are replaced
+	start := types.StatsPeriodHour.Truncate(timestamp)
+	err = suite.database.SaveChainStats(types.StatsPeriodHour, start)
+	suite.Require().NoError(err)
+	err = suite.database.SaveChainStats(types.StatsPeriodHour, start)
+	suite.Require().NoError(err)
+
+	var txCount, successfulTxCount, uniqueSigners, newAccounts int64
+	var successRate float64
+	var gasUsed string
+	err = suite.database.SQL.QueryRow(`
+SELECT tx_count, successful_tx_count, success_rate, unique_signers, new_accounts, gas_used 
+FROM chain_stats WHERE period = 'hour'`,
+	).Scan(&txCount, &successfulTxCount, &successRate, &uniqueSigners, &newAccounts, &gasUsed)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), txCount)
+	suite.Require().Equal(int64(1), successfulTxCount)
+	suite.Require().Equal(0.5, successRate)
+	suite.Require().Equal(int64(1), uniqueSigners)
+	suite.Require().Equal(int64(1), newAccounts)
+	suite.Require().Equal("250", gasUsed)
+
+	var fees string
+	err = suite.database.SQL.QueryRow(`SELECT amount FROM chain_stats_fee WHERE denom = 'uatom'`).Scan(&fees)
+	suite.Require().NoError(err)
+	suite.Require().Equal("150", fees)
+
+	var messagesCount int64
+	err = suite.database.SQL.QueryRow(`SELECT count FROM chain_stats_message WHERE type = 'cosmos.bank.v1beta1.MsgSend'`).Scan(&messagesCount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), messagesCount)
+
+	// The following hour only contains an empty block
+	err = suite.database.SaveChainStats(types.StatsPeriodHour, types.StatsPeriodHour.Next(start))
+	suite.Require().NoError(err)
+
+	var emptyTxCount int64
+	err = suite.database.SQL.QueryRow(
+		`SELECT tx_count FROM chain_stats WHERE period = 'hour' AND start_time = $1`, types.StatsPeriodHour.Next(start),
+	).Scan(&emptyTxCount)
+	suite.Require().NoError(err)
+	suite.Require().Zero(emptyTxCount)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_SaveTransactionError() {
+	timestamp := time.Date(2020, 1, 1, 15, 30, 00, 000, time.UTC)
+
+	_, err := suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
+	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
+	VALUES (10, 'hash10', 2, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $1)`, timestamp)
+	suite.Require().NoError(err)
+
+	msgSend, msgDelegate := "cosmos.bank.v1beta1.MsgSend", "cosmos.staking.v1beta1.MsgDelegate"
+	err = suite.database.SaveTransactionError(
+		types.NewTransactionError("tx1", 10, "sdk", 5, "insufficient funds", []string{msgSend}),
+	)
+	suite.Require().NoError(err)
+
+	// Storing the same error twice should not count it twice
+	for i := 0; i < 2; i++ {
+		err = suite.database.SaveTransactionError(
+			types.NewTransactionError("tx2", 10, "sdk", 5, "insufficient funds", []string{msgSend, msgDelegate}),
+		)
+		suite.Require().NoError(err)
+	}
+
+	var count int64
+	err = suite.database.SQL.QueryRow(`
+SELECT count FROM transaction_error_daily 
+WHERE message_type = 'cosmos.bank.v1beta1.MsgSend' AND codespace = 'sdk' AND code = 5`,
+	).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), count)
+
+	err = suite.database.SQL.QueryRow(`
+SELECT count FROM transaction_error_total WHERE message_type = 'cosmos.staking.v1beta1.MsgDelegate'`,
+	).Scan(&count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), count)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_RebuildMessageTypesUsage() {
+	timestamp := time.Date(2020, 1, 1, 15, 30, 00, 000, time.UTC)
+
+	_, err := suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
+	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
+	VALUES (10, 'hash10', 1, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $1),
+	       (20, 'hash20', 1, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $2)`,
+		timestamp, timestamp.Add(time.Minute))
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`CREATE TABLE transaction_0 PARTITION OF transaction FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`CREATE TABLE message_0 PARTITION OF message FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO transaction (hash, height, success, signatures) VALUES ('tx1', 10, true, '{}'), ('tx2', 20, true, '{}')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO message (transaction_hash, index, type, value, involved_accounts_addresses, height) 
+VALUES ('tx1', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 10),
+       ('tx1', 1, 'cosmos.legacy.v1beta1.MsgOld', '{}', '{}', 10),
+       ('tx2', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 20)`)
+	suite.Require().NoError(err)
+
+	msgSend := types.NewMessageType("cosmos.bank.v1beta1.MsgSend")
+	err = suite.database.SaveRegisteredMessageTypes([]types.MessageType{msgSend})
+	suite.Require().NoError(err)
+
+	// Computing the usage twice should not change the counts
+	err = suite.database.RebuildMessageTypesUsage()
+	suite.Require().NoError(err)
+	err = suite.database.RebuildMessageTypesUsage()
+	suite.Require().NoError(err)
+
+	var registered bool
+	var pkg string
+	var firstSeen, lastSeen, count int64
+	err = suite.database.SQL.QueryRow(`
+SELECT registered, package, first_seen_height, last_seen_height, count FROM message_type WHERE type = $1`, msgSend.Type,
+	).Scan(&registered, &pkg, &firstSeen, &lastSeen, &count)
+	suite.Require().NoError(err)
+	suite.Require().True(registered)
+	suite.Require().Equal("cosmos.bank.v1beta1", pkg)
+	suite.Require().Equal(int64(10), firstSeen)
+	suite.Require().Equal(int64(20), lastSeen)
+	suite.Require().Equal(int64(2), count)
+
+	// Message types that are not registered should be stored as well
+	err = suite.database.SQL.QueryRow(
+		`SELECT registered, package FROM message_type WHERE type = 'cosmos.legacy.v1beta1.MsgOld'`,
+	).Scan(&registered, &pkg)
+	suite.Require().NoError(err)
+	suite.Require().False(registered)
+	suite.Require().Equal("cosmos.legacy.v1beta1", pkg)
+}
+
+func (suite *DbTestSuite) TestBigDipperDb_UpdateMessageTypesUsage() {
+	firstDay := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	secondDay := firstDay.AddDate(0, 0, 1)
+
+	_, err := suite.database.SQL.Exec(`INSERT INTO validator (consensus_address, consensus_pubkey) 
+	VALUES ('desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', 'cosmosvalconspub1zcjduepq7mft6gfls57a0a42d7uhx656cckhfvtrlmw744jv4q0mvlv0dypskehfk8')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`INSERT INTO block(height, hash, num_txs, total_gas, proposer_address, timestamp)
+	VALUES (10, 'hash10', 1, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $1),
+	       (20, 'hash20', 1, 0, 'desmosvalcons1mxrd5cyjgpx5vfgltrdufq9wq4ynwc799ndrg8', $2)`,
+		firstDay.Add(time.Hour), secondDay.Add(time.Hour))
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`CREATE TABLE transaction_0 PARTITION OF transaction FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`CREATE TABLE message_0 PARTITION OF message FOR VALUES IN (0)`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO transaction (hash, height, success, signatures) VALUES ('tx1', 10, true, '{}'), ('tx2', 20, true, '{}')`)
+	suite.Require().NoError(err)
+
+	_, err = suite.database.SQL.Exec(`
+INSERT INTO message (transaction_hash, index, type, value, involved_accounts_addresses, height) 
+VALUES ('tx1', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 10),
+       ('tx2', 0, 'cosmos.bank.v1beta1.MsgSend', '{}', '{}', 20)`)
+	suite.Require().NoError(err)
+
+	for _, day := range []time.Time{firstDay, secondDay} {
+		err = suite.database.SaveChainStats(types.StatsPeriodDay, day)
+		suite.Require().NoError(err)
+	}
+
+	// Updating the usage twice should not change the counts
+	for i := 0; i < 2; i++ {
+		err = suite.database.UpdateMessageTypesUsage(secondDay)
+		suite.Require().NoError(err)
+	}
+
+	// The count includes all the daily rollups, while only the messages of the latest days are scanned
+	var firstSeen, lastSeen, count int64
+	query := `SELECT first_seen_height, last_seen_height, count FROM message_type WHERE type = 'cosmos.bank.v1beta1.MsgSend'`
+	err = suite.database.SQL.QueryRow(query).Scan(&firstSeen, &lastSeen, &count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(20), firstSeen)
+	suite.Require().Equal(int64(20), lastSeen)
+	suite.Require().Equal(int64(2), count)
+
+	// Rebuilding the usage should scan all the messages
+	err = suite.database.RebuildMessageTypesUsage()
+	suite.Require().NoError(err)
+
+	err = suite.database.SQL.QueryRow(query).Scan(&firstSeen, &lastSeen, &count)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), firstSeen)
+	suite.Require().Equal(int64(20), lastSeen)
+	suite.Require().Equal(int64(2), count)
+}
@@ -1,10 +1,28 @@
 package types
 
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
 // AccountRow represents a single row inside the account table
 type AccountRow struct {
 	Address string `db:"address"`
 }
 
+// AccountDetailsRow represents a single row inside the account table, including the on-chain data
+type AccountDetailsRow struct {
+	Address         string         `db:"address"`
+	Type            sql.NullString `db:"type"`
+	PubKeyType      sql.NullString `db:"pubkey_type"`
+	PubKey          sql.NullString `db:"pubkey"`
+	AccountNumber   sql.NullInt64  `db:"account_number"`
+	Sequence        sql.NullInt64  `db:"sequence"`
+	FirstSeenHeight sql.NullInt64  `db:"first_seen_height"`
+	Height          sql.NullInt64  `db:"height"`
+}
+
 // NewAccountRow allows to easily build a new AccountRow
 func NewAccountRow(address string) AccountRow {
 	return AccountRow{
@@ -16,3 +34,59 @@ func NewAccountRow(address string) AccountRow {
 func (a AccountRow) Equal(b AccountRow) bool {
 	return a.Address == b.Address
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ModuleAccountRow represents a single row inside the module_account table
+type ModuleAccountRow struct {
+	Address     string         `db:"address"`
+	Name        string         `db:"name"`
+	Permissions pq.StringArray `db:"permissions"`
+	Height      int64          `db:"height"`
+}
+
+// AccountLabelRow represents a single row inside the account_label table
+type AccountLabelRow struct {
+	Address  string         `db:"address"`
+	Label    string         `db:"label"`
+	Category sql.NullString `db:"category"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AuthParamsRow represents a single row inside the auth_params table
+type AuthParamsRow struct {
+	OneRowID bool   `db:"one_row_id"`
+	Params   string `db:"params"`
+	Height   int64  `db:"height"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// VestingAccountRow represents a single row inside the vesting_account table
+type VestingAccountRow struct {
+	ID              int64        `db:"id"`
+	Type            string       `db:"type"`
+	Address         string       `db:"address"`
+	OriginalVesting DbCoins      `db:"original_vesting"`
+	EndTime         sql.NullTime `db:"end_time"`
+	StartTime       sql.NullTime `db:"start_time"`
+}
+
+// VestingPeriodRow represents a single row inside the vesting_period table
+type VestingPeriodRow struct {
+	VestingAccountID int64   `db:"vesting_account_id"`
+	PeriodOrder      int64   `db:"period_order"`
+	Length           int64   `db:"length"`
+	Amount           DbCoins `db:"amount"`
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// TransactionFeeRow contains the data of a transaction row needed to know who paid its fees
+type TransactionFeeRow struct {
+	Hash     string `db:"hash"`
+	Height   int64  `db:"height"`
+	Messages string `db:"messages"`
+	Fee      string `db:"fee"`
+}
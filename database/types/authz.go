@@ -0,0 +1,17 @@
+package types
+
+import (
+	"database/sql"
+)
+
+// AuthzGrantRow represents a single row inside the authz_grant table
+type AuthzGrantRow struct {
+	ID            uint64       `db:"id"`
+	Granter       string       `db:"granter_address"`
+	Grantee       string       `db:"grantee_address"`
+	MsgTypeURL    string       `db:"msg_type_url"`
+	Authorization string       `db:"authorization_value"`
+	Expiration    sql.NullTime `db:"expiration"`
+	Expired       bool         `db:"expired"`
+	Height        int64        `db:"height"`
+}
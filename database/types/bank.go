@@ -0,0 +1,8 @@
+package types
+
+// BankParamsRow represents a single row inside the bank_params table
+type BankParamsRow struct {
+	OneRowID bool   `db:"one_row_id"`
+	Params   string `db:"params"`
+	Height   int64  `db:"height"`
+}
@@ -95,3 +95,22 @@ type BlockHeightAndTimestamp struct {
 	Height         int64     `db:"height"`
 	BlockTimestamp time.Time `db:"timestamp"`
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ConsensusParamsRow represents a single row inside the consensus_params table
+type ConsensusParamsRow struct {
+	OneRowID bool   `db:"one_row_id"`
+	Params   string `db:"params"`
+	Height   int64  `db:"height"`
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// SuggestedGasPriceRow represents a single row of the suggested gas prices query
+type SuggestedGasPriceRow struct {
+	Denom   string `db:"denom"`
+	Low     string `db:"low"`
+	Average string `db:"average"`
+	High    string `db:"high"`
+}
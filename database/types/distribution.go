@@ -1,5 +1,9 @@
 package types
 
+import (
+	"time"
+)
+
 // DistributionParamsRow represents a single row inside the distribution_params table
 type DistributionParamsRow struct {
 	OneRowID bool   `db:"one_row_id"`
@@ -30,3 +34,135 @@ func (v CommunityPoolRow) Equals(w CommunityPoolRow) bool {
 	return v.Coins.Equal(w.Coins) &&
 		v.Height == w.Height
 }
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// DelegatorRewardWithdrawalRow represents a single row inside the delegator_reward_withdrawal table
+type DelegatorRewardWithdrawalRow struct {
+	DelegatorAddress string  `db:"delegator_address"`
+	ValidatorAddress string  `db:"validator_address"`
+	Amount           DbCoins `db:"amount"`
+	TxHash           string  `db:"transaction_hash"`
+	MsgIndex         int64   `db:"msg_index"`
+	Height           int64   `db:"height"`
+}
+
+// NewDelegatorRewardWithdrawalRow allows to easily create a new DelegatorRewardWithdrawalRow
+func NewDelegatorRewardWithdrawalRow(
+	delegator string, validator string, amount DbCoins, txHash string, msgIndex int64, height int64,
+) DelegatorRewardWithdrawalRow {
+	return DelegatorRewardWithdrawalRow{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+		TxHash:           txHash,
+		MsgIndex:         msgIndex,
+		Height:           height,
+	}
+}
+
+// Equals return true if two DelegatorRewardWithdrawalRow are the same
+func (w DelegatorRewardWithdrawalRow) Equals(v DelegatorRewardWithdrawalRow) bool {
+	return w.DelegatorAddress == v.DelegatorAddress &&
+		w.ValidatorAddress == v.ValidatorAddress &&
+		w.Amount.Equal(&v.Amount) &&
+		w.TxHash == v.TxHash &&
+		w.MsgIndex == v.MsgIndex &&
+		w.Height == v.Height
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ValidatorCommissionWithdrawalRow represents a single row inside the validator_commission_withdrawal table
+type ValidatorCommissionWithdrawalRow struct {
+	ValidatorAddress string  `db:"validator_address"`
+	Amount           DbCoins `db:"amount"`
+	TxHash           string  `db:"transaction_hash"`
+	MsgIndex         int64   `db:"msg_index"`
+	Height           int64   `db:"height"`
+}
+
+// NewValidatorCommissionWithdrawalRow allows to easily create a new ValidatorCommissionWithdrawalRow
+func NewValidatorCommissionWithdrawalRow(
+	validator string, amount DbCoins, txHash string, msgIndex int64, height int64,
+) ValidatorCommissionWithdrawalRow {
+	return ValidatorCommissionWithdrawalRow{
+		ValidatorAddress: validator,
+		Amount:           amount,
+		TxHash:           txHash,
+		MsgIndex:         msgIndex,
+		Height:           height,
+	}
+}
+
+// Equals return true if two ValidatorCommissionWithdrawalRow are the same
+func (w ValidatorCommissionWithdrawalRow) Equals(v ValidatorCommissionWithdrawalRow) bool {
+	return w.ValidatorAddress == v.ValidatorAddress &&
+		w.Amount.Equal(&v.Amount) &&
+		w.TxHash == v.TxHash &&
+		w.MsgIndex == v.MsgIndex &&
+		w.Height == v.Height
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// DelegatorWithdrawAddressRow represents a single row inside the delegator_withdraw_address table
+type DelegatorWithdrawAddressRow struct {
+	DelegatorAddress string `db:"delegator_address"`
+	WithdrawAddress  string `db:"withdraw_address"`
+	Height           int64  `db:"height"`
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// CommunityPoolHistoryRow represents a single row inside the community_pool_history table
+type CommunityPoolHistoryRow struct {
+	Coins  DbDecCoins `db:"coins"`
+	Height int64      `db:"height"`
+}
+
+// CommunityPoolDepositRow represents a single row inside the community_pool_deposit table
+type CommunityPoolDepositRow struct {
+	Depositor string  `db:"depositor_address"`
+	Amount    DbCoins `db:"amount"`
+	TxHash    string  `db:"transaction_hash"`
+	MsgIndex  int64   `db:"msg_index"`
+	Height    int64   `db:"height"`
+}
+
+// CommunityPoolTaxRow represents a single row inside the community_pool_tax table
+type CommunityPoolTaxRow struct {
+	Amount DbDecCoins `db:"amount"`
+	Height int64      `db:"height"`
+}
+
+// CommunityPoolSpendRow represents a single row inside the community_pool_spend table
+type CommunityPoolSpendRow struct {
+	ProposalID uint64  `db:"proposal_id"`
+	MsgIndex   int64   `db:"msg_index"`
+	Recipient  string  `db:"recipient_address"`
+	Amount     DbCoins `db:"amount"`
+	Height     int64   `db:"height"`
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// DelegatorRewardSnapshotRow represents a single row inside the delegator_reward_snapshot table
+type DelegatorRewardSnapshotRow struct {
+	DelegatorAddress string     `db:"delegator_address"`
+	ValidatorAddress string     `db:"validator_address"`
+	Amount           DbDecCoins `db:"amount"`
+	Height           int64      `db:"height"`
+	Timestamp        time.Time  `db:"timestamp"`
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ValidatorRewardsHistoryRow represents a single row inside the validator_rewards_history table
+type ValidatorRewardsHistoryRow struct {
+	ValidatorAddress   string     `db:"validator_address"`
+	OutstandingRewards DbDecCoins `db:"outstanding_rewards"`
+	Commission         DbDecCoins `db:"commission"`
+	Height             int64      `db:"height"`
+	Timestamp          time.Time  `db:"timestamp"`
+}
@@ -1,10 +1,25 @@
 package types
 
+import (
+	"database/sql"
+)
+
 // FeeAllowanceRow represents a single row inside the fee_grant_allowance table
 type FeeAllowanceRow struct {
-	ID        uint64 `db:"id"`
-	Grantee   string `db:"grantee_address"`
-	Granter   string `db:"granter_address"`
-	Allowance string `db:"allowance"`
-	Height    int64  `db:"height"`
+	ID         uint64       `db:"id"`
+	Grantee    string       `db:"grantee_address"`
+	Granter    string       `db:"granter_address"`
+	Allowance  string       `db:"allowance"`
+	Expiration sql.NullTime `db:"expiration"`
+	Expired    bool         `db:"expired"`
+	Height     int64        `db:"height"`
+}
+
+// FeeGrantUsageRow represents a single row inside the fee_grant_usage table
+type FeeGrantUsageRow struct {
+	TxHash  string  `db:"transaction_hash"`
+	Granter string  `db:"granter_address"`
+	Grantee string  `db:"grantee_address"`
+	Fee     DbCoins `db:"fee"`
+	Height  int64   `db:"height"`
 }
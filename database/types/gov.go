@@ -190,6 +190,89 @@ func (w DepositRow) Equals(v DepositRow) bool {
 		w.Height == v.Height
 }
 
+// DepositOutcomeRow represents a single row inside the proposal_deposit_outcome table
+type DepositOutcomeRow struct {
+	ProposalID int64   `db:"proposal_id"`
+	Depositor  string  `db:"depositor_address"`
+	Amount     DbCoins `db:"amount"`
+	Outcome    string  `db:"outcome"`
+	Height     int64   `db:"height"`
+}
+
+// NewDepositOutcomeRow allows to easily create a new DepositOutcomeRow
+func NewDepositOutcomeRow(
+	proposalID int64,
+	depositor string,
+	amount DbCoins,
+	outcome string,
+	height int64,
+) DepositOutcomeRow {
+	return DepositOutcomeRow{
+		ProposalID: proposalID,
+		Depositor:  depositor,
+		Amount:     amount,
+		Outcome:    outcome,
+		Height:     height,
+	}
+}
+
+// Equals return true if two DepositOutcomeRow are the same
+func (w DepositOutcomeRow) Equals(v DepositOutcomeRow) bool {
+	return w.ProposalID == v.ProposalID &&
+		w.Depositor == v.Depositor &&
+		w.Amount.Equal(&v.Amount) &&
+		w.Outcome == v.Outcome &&
+		w.Height == v.Height
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProposalTallyStatusRow represents a single row inside the proposal_tally_status table
+type ProposalTallyStatusRow struct {
+	ProposalID       int64  `db:"proposal_id"`
+	Turnout          string `db:"turnout"`
+	YesRatio         string `db:"yes_ratio"`
+	VetoRatio        string `db:"veto_ratio"`
+	QuorumReached    bool   `db:"quorum_reached"`
+	ProjectedOutcome string `db:"projected_outcome"`
+	Height           int64  `db:"height"`
+}
+
+// NewProposalTallyStatusRow allows to easily create a new ProposalTallyStatusRow
+func NewProposalTallyStatusRow(
+	proposalID int64, turnout, yesRatio, vetoRatio string, quorumReached bool, projectedOutcome string, height int64,
+) ProposalTallyStatusRow {
+	return ProposalTallyStatusRow{
+		ProposalID:       proposalID,
+		Turnout:          turnout,
+		YesRatio:         yesRatio,
+		VetoRatio:        vetoRatio,
+		QuorumReached:    quorumReached,
+		ProjectedOutcome: projectedOutcome,
+		Height:           height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ProposalExecutionResultRow represents a single row inside the proposal_execution_result table
+type ProposalExecutionResultRow struct {
+	ProposalID int64          `db:"proposal_id"`
+	Success    bool           `db:"success"`
+	Log        sql.NullString `db:"log"`
+	Height     int64          `db:"height"`
+}
+
+// NewProposalExecutionResultRow allows to easily create a new ProposalExecutionResultRow
+func NewProposalExecutionResultRow(proposalID int64, success bool, log string, height int64) ProposalExecutionResultRow {
+	return ProposalExecutionResultRow{
+		ProposalID: proposalID,
+		Success:    success,
+		Log:        ToNullString(log),
+		Height:     height,
+	}
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 type ProposalStakingPoolSnapshotRow struct {
@@ -47,3 +47,20 @@ func (m MintParamsRow) Equal(n MintParamsRow) bool {
 	return m.Params == n.Params &&
 		m.Height == n.Height
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AnnualProvisionsHistoryRow represents a single row inside the annual_provisions_history table
+type AnnualProvisionsHistoryRow struct {
+	Value  string `db:"value"`
+	Height int64  `db:"height"`
+}
+
+// BlockProvisionRow represents a single row inside the block_provision table
+type BlockProvisionRow struct {
+	BondedRatio      string `db:"bonded_ratio"`
+	Inflation        string `db:"inflation"`
+	AnnualProvisions string `db:"annual_provisions"`
+	Amount           string `db:"amount"`
+	Height           int64  `db:"height"`
+}
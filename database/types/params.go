@@ -0,0 +1,11 @@
+package types
+
+import "database/sql"
+
+// ModuleParamsHistoryRow represents a single row of the module_params_history table
+type ModuleParamsHistoryRow struct {
+	Module     string        `db:"module"`
+	Params     string        `db:"params"`
+	Height     int64         `db:"height"`
+	ProposalID sql.NullInt64 `db:"proposal_id"`
+}
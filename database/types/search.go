@@ -0,0 +1,13 @@
+package types
+
+import (
+	"database/sql"
+)
+
+// SearchResultRow represents a single row returned by a search query
+type SearchResultRow struct {
+	Type  string         `db:"type"`
+	ID    string         `db:"id"`
+	Title sql.NullString `db:"title"`
+	Rank  float64        `db:"rank"`
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"database/sql"
+	"time"
+)
+
 // SupplyRow represents a single row inside the "supply" table
 type SupplyRow struct {
 	OneRowID bool     `db:"one_row_id"`
@@ -21,3 +26,40 @@ func (v SupplyRow) Equals(w SupplyRow) bool {
 	return v.Coins.Equal(w.Coins) &&
 		v.Height == w.Height
 }
+
+// SupplyHistoryRow represents a single row inside the supply_history table
+type SupplyHistoryRow struct {
+	Coins  DbCoins `db:"coins"`
+	Height int64   `db:"height"`
+}
+
+// SupplyChangeRow represents a single row inside the supply_change table
+type SupplyChangeRow struct {
+	Type       string         `db:"type"`
+	Origin     string         `db:"origin"`
+	Address    string         `db:"address"`
+	Denom      string         `db:"denom"`
+	Amount     string         `db:"amount"`
+	TxHash     sql.NullString `db:"transaction_hash"`
+	EventIndex int64          `db:"event_index"`
+	Height     int64          `db:"height"`
+}
+
+// SupplyChangeTotalRow represents the total amount of a denom minted or burned within a range of heights
+type SupplyChangeTotalRow struct {
+	Type   string `db:"type"`
+	Denom  string `db:"denom"`
+	Amount string `db:"amount"`
+}
+
+// SupplyReconciliationRow represents a single row inside the supply_reconciliation table
+type SupplyReconciliationRow struct {
+	Date        time.Time `db:"date"`
+	Denom       string    `db:"denom"`
+	StartHeight int64     `db:"start_height"`
+	EndHeight   int64     `db:"end_height"`
+	SupplyDelta string    `db:"supply_delta"`
+	Minted      string    `db:"minted"`
+	Burned      string    `db:"burned"`
+	Unexplained string    `db:"unexplained"`
+}
@@ -16,19 +16,31 @@ var (
 
 func (m *Module) RunAdditionalOperations() error {
 	// Build the worker
-	context := actionstypes.NewContext(m.node, m.sources)
+	context := actionstypes.NewContext(m.node, m.sources, m.db)
 	worker := actionstypes.NewActionsWorker(context)
 
 	// Register the endpoints
 
+	// -- Auth --
+	worker.RegisterHandler("/vesting_status", handlers.VestingStatusHandler)
+
 	// -- Bank --
 	worker.RegisterHandler("/account_balance", handlers.AccountBalanceHandler)
 
+	// -- Consensus --
+	worker.RegisterHandler("/suggested_gas_price", handlers.SuggestedGasPriceHandler)
+
 	// -- Distribution --
 	worker.RegisterHandler("/delegation_reward", handlers.DelegationRewardHandler)
 	worker.RegisterHandler("/delegator_withdraw_address", handlers.DelegatorWithdrawAddressHandler)
 	worker.RegisterHandler("/validator_commission_amount", handlers.ValidatorCommissionAmountHandler)
 
+	// -- Gov --
+	worker.RegisterHandler("/proposal_tally_status", handlers.ProposalTallyStatusHandler)
+
+	// -- Search --
+	worker.RegisterHandler("/search", handlers.SearchHandler)
+
 	// -- Staking Delegator --
 	worker.RegisterHandler("/delegation", handlers.DelegationHandler)
 	worker.RegisterHandler("/delegation_total", handlers.TotalDelegationAmountHandler)
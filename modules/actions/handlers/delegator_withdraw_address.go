@@ -12,14 +12,26 @@ func DelegatorWithdrawAddressHandler(ctx *types.Context, payload *types.Payload)
 	log.Debug().Str("address", payload.GetAddress()).
 		Msg("executing delegator withdraw address action")
 
+	// Use the stored withdraw address when available
+	withdrawAddress, err := ctx.Db.GetDelegatorWithdrawAddress(payload.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	if withdrawAddress != "" {
+		return types.Address{
+			Address: withdrawAddress,
+		}, nil
+	}
+
 	// Get latest node height
 	height, err := ctx.GetHeight(nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get delegator's total rewards
-	withdrawAddress, err := ctx.Sources.DistrSource.DelegatorWithdrawAddress(payload.GetAddress(), height)
+	// Get delegator's withdraw address from the chain
+	withdrawAddress, err = ctx.Sources.DistrSource.DelegatorWithdrawAddress(payload.GetAddress(), height)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting delegator withdraw address: %s", err)
 	}
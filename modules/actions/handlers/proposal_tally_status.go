@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+	bdjunotypes "github.com/forbole/bdjuno/v4/types"
+)
+
+func ProposalTallyStatusHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Uint64("proposal_id", payload.Input.ProposalID).
+		Int64("height", payload.Input.Height).
+		Msg("executing proposal tally status action")
+
+	height, err := ctx.GetHeight(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ctx.Sources.GovSource.TallyResult(height, payload.Input.ProposalID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting tally result: %s", err)
+	}
+
+	params, err := ctx.Sources.GovSource.Params(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting gov params: %s", err)
+	}
+
+	pool, err := ctx.Sources.StakingSource.GetPool(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting staking pool: %s", err)
+	}
+
+	tally := bdjunotypes.NewTallyResult(
+		payload.Input.ProposalID,
+		result.YesCount,
+		result.AbstainCount,
+		result.NoCount,
+		result.NoWithVetoCount,
+		height,
+	)
+
+	status, err := bdjunotypes.ComputeProposalTallyStatus(tally, params, pool.BondedTokens)
+	if err != nil {
+		return nil, fmt.Errorf("error while computing tally status: %s", err)
+	}
+
+	return types.ProposalTallyStatus{
+		ProposalID:       status.ProposalID,
+		Turnout:          status.Turnout.String(),
+		YesRatio:         status.YesRatio.String(),
+		VetoRatio:        status.VetoRatio.String(),
+		QuorumReached:    status.QuorumReached,
+		ProjectedOutcome: status.ProjectedOutcome,
+		Height:           status.Height,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+	bdjunotypes "github.com/forbole/bdjuno/v4/types"
+)
+
+const (
+	// defaultSearchLimit represents the number of results returned when no limit is given
+	defaultSearchLimit = 10
+
+	// maxSearchLimit represents the max number of results that can be returned
+	maxSearchLimit = 100
+)
+
+func SearchHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	query := payload.GetQuery()
+	log.Debug().Str("query", query).Msg("executing search action")
+
+	if query == "" {
+		return nil, fmt.Errorf("empty search query")
+	}
+
+	limit := int(payload.Input.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	results, err := Search(ctx.Db, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]types.SearchResult, len(results))
+	for i, result := range results {
+		response[i] = types.SearchResult{
+			Type:  result.Type,
+			ID:    result.ID,
+			Title: result.Title,
+			Rank:  result.Rank,
+		}
+	}
+
+	return response, nil
+}
+
+// SearchDb represents the database queries used to search the entities
+type SearchDb interface {
+	SearchHeight(height int64) ([]bdjunotypes.SearchResult, error)
+	SearchHash(hash string) ([]bdjunotypes.SearchResult, error)
+	SearchAddress(address string) ([]bdjunotypes.SearchResult, error)
+	SearchText(query string, limit int) ([]bdjunotypes.SearchResult, error)
+}
+
+// Search returns at most limit entities matching the given query, recognizing heights, hashes and addresses
+// before falling back to the full-text search.
+// Exact matches always come first, followed by the text matches sorted by rank
+func Search(db SearchDb, query string, limit int) ([]bdjunotypes.SearchResult, error) {
+	var exact []bdjunotypes.SearchResult
+
+	if height, err := strconv.ParseInt(query, 10, 64); err == nil && height > 0 {
+		matches, err := db.SearchHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		exact = append(exact, matches...)
+	}
+
+	if bz, err := hex.DecodeString(query); err == nil && len(bz) == 32 {
+		matches, err := db.SearchHash(query)
+		if err != nil {
+			return nil, err
+		}
+		exact = append(exact, matches...)
+	}
+
+	if isBech32Address(query) {
+		matches, err := db.SearchAddress(query)
+		if err != nil {
+			return nil, err
+		}
+		exact = append(exact, matches...)
+	}
+
+	text, err := db.SearchText(query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(text, func(i, j int) bool {
+		return text[i].Rank > text[j].Rank
+	})
+
+	// Skip the text matches that have already been found as exact matches
+	found := make(map[string]bool, len(exact))
+	for _, result := range exact {
+		found[result.Type+result.ID] = true
+	}
+
+	results := exact
+	for _, result := range text {
+		if !found[result.Type+result.ID] {
+			results = append(results, result)
+		}
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+// isBech32Address tells whether the given value is an account, validator operator or validator consensus address
+func isBech32Address(value string) bool {
+	prefix, _, err := bech32.DecodeAndConvert(value)
+	if err != nil {
+		return false
+	}
+
+	cfg := sdk.GetConfig()
+	return prefix == cfg.GetBech32AccountAddrPrefix() ||
+		prefix == cfg.GetBech32ValidatorAddrPrefix() ||
+		prefix == cfg.GetBech32ConsensusAddrPrefix()
+}
@@ -0,0 +1,99 @@
+package handlers_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/handlers"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// fakeSearchDb implements handlers.SearchDb recording which queries have been executed
+type fakeSearchDb struct {
+	calls []string
+	text  []types.SearchResult
+}
+
+func (db *fakeSearchDb) SearchHeight(height int64) ([]types.SearchResult, error) {
+	db.calls = append(db.calls, "height")
+	return []types.SearchResult{types.NewSearchResult(types.SearchResultBlock, "12345", "", 1)}, nil
+}
+
+func (db *fakeSearchDb) SearchHash(hash string) ([]types.SearchResult, error) {
+	db.calls = append(db.calls, "hash")
+	return []types.SearchResult{types.NewSearchResult(types.SearchResultTransaction, strings.ToUpper(hash), "", 1)}, nil
+}
+
+func (db *fakeSearchDb) SearchAddress(address string) ([]types.SearchResult, error) {
+	db.calls = append(db.calls, "address")
+	return []types.SearchResult{types.NewSearchResult(types.SearchResultAccount, address, "", 1)}, nil
+}
+
+func (db *fakeSearchDb) SearchText(_ string, _ int) ([]types.SearchResult, error) {
+	db.calls = append(db.calls, "text")
+	return db.text, nil
+}
+
+func TestSearch_QueryKinds(t *testing.T) {
+	addressBz := sdk.AccAddress(make([]byte, 20))
+	otherChainAddress, err := bech32.ConvertAndEncode("osmo", addressBz)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		query         string
+		expectedCalls []string
+	}{
+		{name: "height", query: "12345", expectedCalls: []string{"height", "text"}},
+		{name: "zero is not a height", query: "0", expectedCalls: []string{"text"}},
+		{name: "negative number is not a height", query: "-10", expectedCalls: []string{"text"}},
+		{name: "32 bytes hex hash", query: strings.Repeat("ab", 32), expectedCalls: []string{"hash", "text"}},
+		{name: "short hex value is not a hash", query: strings.Repeat("ab", 20), expectedCalls: []string{"text"}},
+		{name: "account address", query: addressBz.String(), expectedCalls: []string{"address", "text"}},
+		{name: "validator operator address", query: sdk.ValAddress(addressBz).String(), expectedCalls: []string{"address", "text"}},
+		{name: "validator consensus address", query: sdk.ConsAddress(addressBz).String(), expectedCalls: []string{"address", "text"}},
+		{name: "address of another chain", query: otherChainAddress, expectedCalls: []string{"text"}},
+		{name: "text", query: "cosmos hub upgrade", expectedCalls: []string{"text"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db := &fakeSearchDb{}
+			_, err := handlers.Search(db, tc.query, 10)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCalls, db.calls)
+		})
+	}
+}
+
+func TestSearch_ExactMatchesFirst(t *testing.T) {
+	db := &fakeSearchDb{
+		text: []types.SearchResult{
+			types.NewSearchResult(types.SearchResultProposal, "1", "Block 12345 upgrade", 0.5),
+			types.NewSearchResult(types.SearchResultValidator, "cosmosvaloper1", "12345", 1.2),
+			types.NewSearchResult(types.SearchResultBlock, "12345", "", 0.1),
+		},
+	}
+
+	results, err := handlers.Search(db, "12345", 10)
+	require.NoError(t, err)
+
+	// The exact match comes first even though some text matches have a higher rank,
+	// and it is not returned twice
+	require.Equal(t, []types.SearchResult{
+		types.NewSearchResult(types.SearchResultBlock, "12345", "", 1),
+		types.NewSearchResult(types.SearchResultValidator, "cosmosvaloper1", "12345", 1.2),
+		types.NewSearchResult(types.SearchResultProposal, "1", "Block 12345 upgrade", 0.5),
+	}, results)
+
+	// The limit applies to all the results
+	results, err = handlers.Search(db, "12345", 2)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, types.SearchResultBlock, results[0].Type)
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+)
+
+const (
+	// defaultGasPriceBlocks represents the number of latest blocks used to suggest the gas prices when none is given
+	defaultGasPriceBlocks = 100
+
+	// maxGasPriceBlocks represents the max number of latest blocks that can be used to suggest the gas prices
+	maxGasPriceBlocks = 10000
+)
+
+func SuggestedGasPriceHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	blocks := payload.GetBlocks(defaultGasPriceBlocks)
+	if blocks > maxGasPriceBlocks {
+		blocks = maxGasPriceBlocks
+	}
+	log.Debug().Int64("blocks", blocks).Msg("executing suggested gas price action")
+
+	block, err := ctx.Db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return nil, fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	prices, err := ctx.Db.GetSuggestedGasPrices(block.Height - blocks + 1)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting suggested gas prices: %s", err)
+	}
+
+	response := make([]types.SuggestedGasPrice, len(prices))
+	for i, price := range prices {
+		response[i] = types.SuggestedGasPrice{
+			Denom:   price.Denom,
+			Low:     price.Low.String(),
+			Average: price.Average.String(),
+			High:    price.High.String(),
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/actions/types"
+	"github.com/forbole/bdjuno/v4/modules/auth"
+)
+
+func VestingStatusHandler(ctx *types.Context, payload *types.Payload) (interface{}, error) {
+	log.Debug().Str("address", payload.GetAddress()).
+		Time("time", payload.GetTime()).
+		Msg("executing vesting status action")
+
+	status, err := auth.GetVestingStatus(ctx.Db, payload.GetAddress(), payload.GetTime())
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting status: %s", err)
+	}
+
+	if status == nil {
+		return nil, fmt.Errorf("account %s is not a vesting account", payload.GetAddress())
+	}
+
+	return types.VestingStatus{
+		Address:         status.Address,
+		Time:            status.Time,
+		OriginalVesting: types.ConvertCoins(status.OriginalVesting),
+		Vested:          types.ConvertCoins(status.Vested),
+		Locked:          types.ConvertCoins(status.Locked),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/forbole/juno/v5/node"
 
+	"github.com/forbole/bdjuno/v4/database"
 	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
@@ -12,13 +13,15 @@ import (
 type Context struct {
 	node    node.Node
 	Sources *modulestypes.Sources
+	Db      *database.Db
 }
 
 // NewContext returns a new Context instance
-func NewContext(node node.Node, sources *modulestypes.Sources) *Context {
+func NewContext(node node.Node, sources *modulestypes.Sources, db *database.Db) *Context {
 	return &Context{
 		node:    node,
 		Sources: sources,
+		Db:      db,
 	}
 }
 
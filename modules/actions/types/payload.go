@@ -1,6 +1,11 @@
 package types
 
-import "github.com/cosmos/cosmos-sdk/types/query"
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
 
 // Payload contains the payload data that is sent from Hasura
 type Payload struct {
@@ -13,6 +18,27 @@ func (p *Payload) GetAddress() string {
 	return p.Input.Address
 }
 
+// GetTime returns the time associated with this payload, or the current time if none is given
+func (p *Payload) GetTime() time.Time {
+	if p.Input.Time == nil {
+		return time.Now().UTC()
+	}
+	return *p.Input.Time
+}
+
+// GetBlocks returns the number of blocks associated with this payload, or the given default value if none is given
+func (p *Payload) GetBlocks(defaultValue int64) int64 {
+	if p.Input.Blocks <= 0 {
+		return defaultValue
+	}
+	return p.Input.Blocks
+}
+
+// GetQuery returns the search query associated with this payload, if any
+func (p *Payload) GetQuery() string {
+	return strings.TrimSpace(p.Input.Query)
+}
+
 // GetPagination returns the pagination asasociated with this payload, if any
 func (p *Payload) GetPagination() *query.PageRequest {
 	return &query.PageRequest{
@@ -23,9 +49,13 @@ func (p *Payload) GetPagination() *query.PageRequest {
 }
 
 type PayloadArgs struct {
-	Address    string `json:"address"`
-	Height     int64  `json:"height"`
-	Offset     uint64 `json:"offset"`
-	Limit      uint64 `json:"limit"`
-	CountTotal bool   `json:"count_total"`
+	Address    string     `json:"address"`
+	Height     int64      `json:"height"`
+	Offset     uint64     `json:"offset"`
+	Limit      uint64     `json:"limit"`
+	CountTotal bool       `json:"count_total"`
+	ProposalID uint64     `json:"proposal_id"`
+	Time       *time.Time `json:"time"`
+	Blocks     int64      `json:"blocks"`
+	Query      string     `json:"query"`
 }
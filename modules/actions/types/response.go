@@ -99,3 +99,43 @@ type RedelegationEntry struct {
 	CompletionTime time.Time   `json:"completion_time"`
 	Balance        sdkmath.Int `json:"balance"`
 }
+
+// ========================= Proposal Tally Status Response =========================
+
+type ProposalTallyStatus struct {
+	ProposalID       uint64 `json:"proposal_id"`
+	Turnout          string `json:"turnout"`
+	YesRatio         string `json:"yes_ratio"`
+	VetoRatio        string `json:"veto_ratio"`
+	QuorumReached    bool   `json:"quorum_reached"`
+	ProjectedOutcome string `json:"projected_outcome"`
+	Height           int64  `json:"height"`
+}
+
+// ========================= Vesting Status Response =========================
+
+type VestingStatus struct {
+	Address         string    `json:"address"`
+	Time            time.Time `json:"time"`
+	OriginalVesting []Coin    `json:"original_vesting"`
+	Vested          []Coin    `json:"vested"`
+	Locked          []Coin    `json:"locked"`
+}
+
+// ========================= Suggested Gas Price Response =========================
+
+type SuggestedGasPrice struct {
+	Denom   string `json:"denom"`
+	Low     string `json:"low"`
+	Average string `json:"average"`
+	High    string `json:"high"`
+}
+
+// ========================= Search Response =========================
+
+type SearchResult struct {
+	Type  string  `json:"type"`
+	ID    string  `json:"id"`
+	Title string  `json:"title"`
+	Rank  float64 `json:"rank"`
+}
@@ -2,10 +2,14 @@ package auth
 
 import (
 	"encoding/json"
+	"fmt"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/forbole/bdjuno/v4/types"
 )
@@ -32,19 +36,98 @@ func GetGenesisAccounts(appState map[string]json.RawMessage, cdc codec.Codec) ([
 	return accounts, nil
 }
 
+// GetGenesisAccountsDetails parses the given appState and returns the details of the genesis accounts
+func GetGenesisAccountsDetails(appState map[string]json.RawMessage, cdc codec.Codec, height int64) ([]types.AccountDetails, error) {
+	var authState authttypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authttypes.ModuleName], &authState); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]types.AccountDetails, len(authState.Accounts))
+	for index, account := range authState.Accounts {
+		var accountI authttypes.AccountI
+		err := cdc.UnpackAny(account, &accountI)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[index] = types.NewAccountDetails(accountI, height)
+	}
+
+	return accounts, nil
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
-// GetAccounts returns the account data for the given addresses
-func GetAccounts(height int64, addresses []string) []types.Account {
+const (
+	// accountsBatchSize represents the number of accounts that are queried concurrently
+	accountsBatchSize = 50
+)
+
+// GetAccountsDetails queries the chain for the data of the accounts having the given addresses at the given height.
+// The accounts are queried concurrently in batches. Addresses that do not exist on chain yet are not returned,
+// while the ones whose type cannot be decoded are returned with their type only
+func (m *Module) GetAccountsDetails(height int64, addresses []string) ([]types.AccountDetails, error) {
 	log.Debug().Str("module", "auth").Str("operation", "accounts").Msg("getting accounts data")
 
-	// Get all the accounts information
-	var accounts = make([]types.Account, len(addresses))
-	for index, address := range addresses {
-		accounts[index] = types.NewAccount(address)
+	var accounts []types.AccountDetails
+	for start := 0; start < len(addresses); start += accountsBatchSize {
+		end := start + accountsBatchSize
+		if end > len(addresses) {
+			end = len(addresses)
+		}
+		batch := addresses[start:end]
+
+		results := make([]*types.AccountDetails, len(batch))
+		errs := make([]error, len(batch))
+
+		var wg sync.WaitGroup
+		for index, address := range batch {
+			wg.Add(1)
+			go func(index int, address string) {
+				defer wg.Done()
+				results[index], errs[index] = m.getAccountDetails(height, address)
+			}(index, address)
+		}
+		wg.Wait()
+
+		for index, result := range results {
+			if errs[index] != nil {
+				return nil, errs[index]
+			}
+
+			if result != nil {
+				accounts = append(accounts, *result)
+			}
+		}
 	}
 
-	return accounts
+	return accounts, nil
+}
+
+// getAccountDetails returns the data of the account having the given address at the given height,
+// or nil if the account does not exist on chain
+func (m *Module) getAccountDetails(height int64, address string) (*types.AccountDetails, error) {
+	accountAny, err := m.source.Account(address, height)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while getting account %s: %s", address, err)
+	}
+
+	var accountI authttypes.AccountI
+	err = m.cdc.UnpackAny(accountAny, &accountI)
+	if err != nil {
+		// Accounts whose type is not registered (eg. interchain accounts) can't be decoded, so we only store their type
+		log.Debug().Str("module", "auth").Str("address", address).Str("type", accountAny.TypeUrl).
+			Msg("storing account of unknown type")
+		details := types.NewUnknownAccountDetails(address, accountAny.TypeUrl, height)
+		return &details, nil
+	}
+
+	details := types.NewAccountDetails(accountI, height)
+	return &details, nil
 }
 
 // RefreshAccounts takes the given addresses and for each one queries the chain
@@ -53,6 +136,39 @@ func (m *Module) RefreshAccounts(height int64, addresses []string) error {
 	if len(addresses) == 0 {
 		return nil
 	}
-	accounts := GetAccounts(height, addresses)
-	return m.db.SaveAccounts(accounts)
+
+	// Store all the addresses first, so that the ones not existing on chain are stored as well
+	accounts := make([]types.Account, len(addresses))
+	for index, address := range addresses {
+		accounts[index] = types.NewAccount(address)
+	}
+
+	err := m.db.SaveAccounts(accounts)
+	if err != nil {
+		return err
+	}
+
+	details, err := m.GetAccountsDetails(height, addresses)
+	if err != nil {
+		return err
+	}
+
+	err = m.db.SaveAccountsDetails(details)
+	if err != nil {
+		return err
+	}
+
+	// Store the composition of the multisig accounts
+	for _, account := range details {
+		if account.PubKey == nil {
+			continue
+		}
+
+		err = m.saveMultisigPubKey(account.PubKey, account.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// saveAccountsActivity stores the activity of the given accounts, involved inside the message having the given index
+func (m *Module) saveAccountsActivity(addresses []string, index int, msg sdk.Msg, tx *juno.Tx) error {
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	// The parser might return the same address more than once
+	seen := map[string]bool{}
+	var activities []types.AccountActivity
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		activities = append(activities, types.NewAccountActivity(
+			address, tx.TxHash, index, proto.MessageName(msg), tx.Height, timestamp,
+		))
+	}
+
+	return m.db.SaveAccountsActivity(activities)
+}
+
+// saveFeesPaid stores the fees paid by the signer of the given transaction.
+// Fees that have been paid using a fee grant are not accounted to the signer
+func (m *Module) saveFeesPaid(tx *juno.Tx) error {
+	if tx.AuthInfo.Fee == nil || tx.FeeGranter() != nil {
+		return nil
+	}
+
+	return m.db.SaveAccountsFeesPaid([]types.AccountFees{
+		types.NewAccountFees(tx.FeePayer().String(), tx.TxHash, tx.AuthInfo.Fee.Amount, tx.Height),
+	})
+}
+
+// BackfillAccountsActivity indexes the accounts activity of the messages and transactions stored
+// between the given heights, processing batchSize blocks at a time.
+// Messages and transactions that have already been indexed are skipped, so this can run alongside the parser
+func (m *Module) BackfillAccountsActivity(fromHeight, toHeight, batchSize int64) error {
+	for start := fromHeight; start <= toHeight; start += batchSize {
+		end := start + batchSize - 1
+		if end > toHeight {
+			end = toHeight
+		}
+
+		log.Info().Str("module", "auth").Int64("from", start).Int64("to", end).
+			Msg("backfilling accounts activity")
+
+		err := m.db.BackfillAccountsActivity(start, end)
+		if err != nil {
+			return err
+		}
+
+		err = m.backfillFeesPaid(start, end)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillFeesPaid stores the fees paid by the signers of the transactions stored between the given heights
+func (m *Module) backfillFeesPaid(fromHeight, toHeight int64) error {
+	rows, err := m.db.GetTransactionsFees(fromHeight, toHeight)
+	if err != nil {
+		return fmt.Errorf("error while getting transactions fees: %s", err)
+	}
+
+	var fees []types.AccountFees
+	for _, row := range rows {
+		var fee tx.Fee
+		err = m.cdc.UnmarshalJSON([]byte(row.Fee), &fee)
+		if err != nil {
+			return fmt.Errorf("error while unmarshaling fee of transaction %s: %s", row.Hash, err)
+		}
+
+		if fee.Granter != "" || fee.Amount.IsZero() {
+			continue
+		}
+
+		payer := fee.Payer
+		if payer == "" {
+			payer, err = m.getFirstSigner(row.Messages)
+			if err != nil {
+				// Messages that are not registered inside the codec can't be decoded, so we skip them
+				log.Error().Str("module", "auth").Err(err).Str("tx", row.Hash).
+					Msg("error while getting transaction signer")
+				continue
+			}
+		}
+
+		fees = append(fees, types.NewAccountFees(payer, row.Hash, fee.Amount, row.Height))
+	}
+
+	return m.db.SaveAccountsFeesPaid(fees)
+}
+
+// getFirstSigner returns the first signer of the given JSON-encoded transaction messages,
+// which is the one paying the fees when no payer is set
+func (m *Module) getFirstSigner(messagesJSON string) (string, error) {
+	var messages []json.RawMessage
+	err := json.Unmarshal([]byte(messagesJSON), &messages)
+	if err != nil {
+		return "", err
+	}
+
+	if len(messages) == 0 {
+		return "", fmt.Errorf("transaction has no messages")
+	}
+
+	var msg sdk.Msg
+	err = m.cdc.UnmarshalInterfaceJSON(messages[0], &msg)
+	if err != nil {
+		return "", err
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return "", fmt.Errorf("message has no signers")
+	}
+
+	return signers[0].String(), nil
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// labelEntry represents a single entry inside the labels registry file
+type labelEntry struct {
+	Label    string `yaml:"label"`
+	Category string `yaml:"category"`
+}
+
+// ReadLabelsFile reads the labels registry contained inside the file having the given path.
+// The file must contain a YAML map having the accounts addresses as keys, eg:
+//
+//	cosmos1...:
+//	  label: Foundation treasury
+//	  category: treasury
+func ReadLabelsFile(path string) ([]types.AccountLabel, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading labels file: %s", err)
+	}
+
+	var entries map[string]labelEntry
+	err = yaml.Unmarshal(bz, &entries)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing labels file: %s", err)
+	}
+
+	labels := make([]types.AccountLabel, 0, len(entries))
+	for address, entry := range entries {
+		if entry.Label == "" {
+			return nil, fmt.Errorf("missing label for account %s", address)
+		}
+		labels = append(labels, types.NewAccountLabel(address, entry.Label, entry.Category))
+	}
+
+	// Sort the labels to make the insertion deterministic
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Address < labels[j].Address
+	})
+
+	return labels, nil
+}
+
+// LoadLabels reads the configured labels registry and stores its content inside the database,
+// replacing the labels previously stored
+func (m *Module) LoadLabels() error {
+	if m.cfg.LabelsFile == "" {
+		return nil
+	}
+
+	labels, err := ReadLabelsFile(m.cfg.LabelsFile)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveAccountLabels(labels)
+}
@@ -0,0 +1,41 @@
+package auth_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/auth"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestReadLabelsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "labels.yaml")
+	err := os.WriteFile(path, []byte(`
+cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs:
+  label: Exchange
+  category: exchange
+cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a:
+  label: Foundation
+`), 0600)
+	require.NoError(t, err)
+
+	labels, err := auth.ReadLabelsFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []types.AccountLabel{
+		types.NewAccountLabel("cosmos184ma3twcfjqef6k95ne8w2hk80x2kah7vcwy4a", "Foundation", ""),
+		types.NewAccountLabel("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs", "Exchange", "exchange"),
+	}, labels)
+
+	// Entries without a label are not valid
+	err = os.WriteFile(path, []byte(`
+cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs:
+  category: exchange
+`), 0600)
+	require.NoError(t, err)
+
+	_, err = auth.ReadLabelsFile(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// GetGenesisModuleAccounts parses the given appState and returns the genesis module accounts
+func GetGenesisModuleAccounts(appState map[string]json.RawMessage, cdc codec.Codec, height int64) ([]types.ModuleAccount, error) {
+	var authState authttypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authttypes.ModuleName], &authState); err != nil {
+		return nil, err
+	}
+
+	var moduleAccounts []types.ModuleAccount
+	for _, account := range authState.Accounts {
+		var accountI authttypes.AccountI
+		err := cdc.UnpackAny(account, &accountI)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleAccount, ok := accountI.(authttypes.ModuleAccountI)
+		if !ok {
+			continue
+		}
+		moduleAccounts = append(moduleAccounts, types.NewModuleAccount(moduleAccount, height))
+	}
+
+	return moduleAccounts, nil
+}
+
+// UpdateModuleAccounts refreshes the module accounts stored inside the database using the latest block height
+func (m *Module) UpdateModuleAccounts() error {
+	log.Trace().Str("module", "auth").Str("operation", "module accounts").
+		Msg("updating module accounts")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	return m.RefreshModuleAccounts(block.Height)
+}
+
+// RefreshModuleAccounts queries the chain for all the module accounts at the given height and stores them
+func (m *Module) RefreshModuleAccounts(height int64) error {
+	accounts, err := m.source.ModuleAccounts(height)
+	if err != nil {
+		return fmt.Errorf("error while getting module accounts: %s", err)
+	}
+
+	moduleAccounts := make([]types.ModuleAccount, len(accounts))
+	for index, account := range accounts {
+		var moduleAccount authttypes.ModuleAccountI
+		err = m.cdc.UnpackAny(account, &moduleAccount)
+		if err != nil {
+			return fmt.Errorf("error while unpacking module account: %s", err)
+		}
+
+		moduleAccounts[index] = types.NewModuleAccount(moduleAccount, height)
+	}
+
+	return m.db.SaveModuleAccounts(moduleAccounts)
+}
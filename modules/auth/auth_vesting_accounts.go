@@ -2,6 +2,7 @@ package auth
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -33,3 +34,25 @@ func GetGenesisVestingAccounts(appState map[string]json.RawMessage, cdc codec.Co
 
 	return vestingAccounts, nil
 }
+
+// RefreshVestingAccount queries the chain for the account having the given address at the given height
+// and stores it inside the database, if it is a vesting account
+func (m *Module) RefreshVestingAccount(address string, height int64) error {
+	accountAny, err := m.source.Account(address, height)
+	if err != nil {
+		return fmt.Errorf("error while getting account %s: %s", address, err)
+	}
+
+	var accountI authttypes.AccountI
+	err = m.cdc.UnpackAny(accountAny, &accountI)
+	if err != nil {
+		return fmt.Errorf("error while unpacking account %s: %s", address, err)
+	}
+
+	vestingAccount, ok := accountI.(exported.VestingAccount)
+	if !ok {
+		return fmt.Errorf("account %s is not a vesting account", address)
+	}
+
+	return m.db.SaveVestingAccounts([]exported.VestingAccount{vestingAccount})
+}
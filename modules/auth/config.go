@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the auth module
+type Config struct {
+	// LabelsFile is the path of the YAML file containing the labels to be associated to the accounts
+	LabelsFile string `yaml:"labels_file"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(labelsFile string) *Config {
+	return &Config{
+		LabelsFile: labelsFile,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig("")
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"auth"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+
+	if cfg.Config == nil {
+		return DefaultConfig(), err
+	}
+
+	return cfg.Config, err
+}
@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RunAdditionalOperations implements modules.AdditionalOperationsModule
+func (m *Module) RunAdditionalOperations() error {
+	log.Debug().Str("module", "auth").Msg("loading account labels")
+
+	err := m.LoadLabels()
+	if err != nil {
+		return fmt.Errorf("error while loading account labels: %s", err)
+	}
+
+	return nil
+}
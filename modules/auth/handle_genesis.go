@@ -5,12 +5,15 @@ import (
 	"fmt"
 
 	tmtypes "github.com/cometbft/cometbft/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 // HandleGenesis implements modules.GenesisModule
-func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
 	log.Debug().Str("module", "auth").Msg("parsing genesis")
 
 	accounts, err := GetGenesisAccounts(appState, m.cdc)
@@ -22,6 +25,24 @@ func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.R
 		return fmt.Errorf("error while storing genesis accounts: %s", err)
 	}
 
+	accountsDetails, err := GetGenesisAccountsDetails(appState, m.cdc, doc.InitialHeight)
+	if err != nil {
+		return fmt.Errorf("error while getting genesis accounts details: %s", err)
+	}
+	err = m.db.SaveAccountsDetails(accountsDetails)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis accounts details: %s", err)
+	}
+
+	moduleAccounts, err := GetGenesisModuleAccounts(appState, m.cdc, doc.InitialHeight)
+	if err != nil {
+		return fmt.Errorf("error while getting genesis module accounts: %s", err)
+	}
+	err = m.db.SaveModuleAccounts(moduleAccounts)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis module accounts: %s", err)
+	}
+
 	vestingAccounts, err := GetGenesisVestingAccounts(appState, m.cdc)
 	if err != nil {
 		return fmt.Errorf("error while getting genesis vesting accounts: %s", err)
@@ -31,5 +52,22 @@ func (m *Module) HandleGenesis(_ *tmtypes.GenesisDoc, appState map[string]json.R
 		return fmt.Errorf("error while storing genesis vesting accounts: %s", err)
 	}
 
+	err = m.UpdateVestingUnlockCalendar()
+	if err != nil {
+		return fmt.Errorf("error while updating vesting unlock calendar: %s", err)
+	}
+
+	// Save the params
+	var genState authtypes.GenesisState
+	err = m.cdc.UnmarshalJSON(appState[authtypes.ModuleName], &genState)
+	if err != nil {
+		return fmt.Errorf("error while reading auth genesis data: %s", err)
+	}
+
+	err = m.db.SaveAuthParams(types.NewAuthParams(genState.Params, doc.InitialHeight))
+	if err != nil {
+		return fmt.Errorf("error while storing genesis auth params: %s", err)
+	}
+
 	return nil
 }
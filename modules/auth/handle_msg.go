@@ -11,19 +11,33 @@ import (
 	"github.com/rs/zerolog/log"
 
 	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/forbole/bdjuno/v4/modules/utils"
-	"github.com/forbole/bdjuno/v4/types"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
-func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg sdk.Msg, tx *juno.Tx) error {
-	return m.HandleMsg(index, executedMsg, tx)
+func (m *Module) HandleMsgExec(_ int, _ *authz.MsgExec, _ int, executedMsg sdk.Msg, tx *juno.Tx) error {
+	// The activity is only tracked for the MsgExec itself, the same way it's stored inside the message table
+	return m.handleMsg(m.getInvolvedAccounts(executedMsg), executedMsg, tx)
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
+	accounts := m.getInvolvedAccounts(msg)
+
+	// Store the activity first so that it's not lost when the accounts can't be refreshed (eg. pruned node)
+	err := m.saveAccountsActivity(accounts, index, msg, tx)
+	if err != nil {
+		return err
+	}
+
+	return m.handleMsg(accounts, msg, tx)
+}
+
+// getInvolvedAccounts returns the addresses of the accounts involved inside the given message
+func (m *Module) getInvolvedAccounts(msg sdk.Msg) []string {
 	addresses, err := m.messagesParser(m.cdc, msg)
 	if err != nil {
 		log.Error().Str("module", "auth").Err(err).
@@ -31,41 +45,103 @@ func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
 			Msgf("error while refreshing accounts after message of type %s", proto.MessageName(msg))
 	}
 
-	if cosmosMsg, ok := msg.(*vestingtypes.MsgCreateVestingAccount); ok {
-		// Store tx timestamp as start_time of the created vesting account
+	return utils.FilterNonAccountAddresses(addresses)
+}
+
+// handleMsg stores the vesting account created by the given message, if any, and refreshes the given accounts
+func (m *Module) handleMsg(accounts []string, msg sdk.Msg, tx *juno.Tx) error {
+	err := m.handleVestingMsg(msg, tx)
+	if err != nil {
+		return err
+	}
+
+	return m.RefreshAccounts(tx.Height, accounts)
+}
+
+// handleVestingMsg stores the vesting account created by the given message, if it is a vesting one
+func (m *Module) handleVestingMsg(msg sdk.Msg, tx *juno.Tx) error {
+	vestingAccount, err := GetCreatedVestingAccount(msg, tx)
+	if err != nil {
+		return err
+	}
+
+	if vestingAccount == nil {
+		return nil
+	}
+
+	err = m.db.SaveVestingAccounts([]exported.VestingAccount{vestingAccount})
+	if err != nil {
+		return fmt.Errorf("error while storing vesting account from %s: %s", proto.MessageName(msg), err)
+	}
+
+	return nil
+}
+
+// GetCreatedVestingAccount returns the vesting account created by the given message,
+// or nil if the message does not create a vesting account or its transaction has failed
+func GetCreatedVestingAccount(msg sdk.Msg, tx *juno.Tx) (exported.VestingAccount, error) {
+	// Failed transactions do not create any account
+	if len(tx.Logs) == 0 {
+		return nil, nil
+	}
+
+	var vestingAccount exported.VestingAccount
+	switch cosmosMsg := msg.(type) {
+	case *vestingtypes.MsgCreateVestingAccount:
+		// Continuous vesting accounts start vesting at the block time
 		timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
 		if err != nil {
-			return fmt.Errorf("error while parsing time: %s", err)
+			return nil, fmt.Errorf("error while parsing time: %s", err)
 		}
 
-		err = m.handleMsgCreateVestingAccount(cosmosMsg, timestamp)
+		baseAccount, err := newBaseAccount(cosmosMsg.ToAddress)
 		if err != nil {
-			return fmt.Errorf("error while handling MsgCreateVestingAccount %s", err)
+			return nil, err
 		}
-	}
 
-	return m.RefreshAccounts(tx.Height, utils.FilterNonAccountAddresses(addresses))
-}
+		bva := vestingtypes.NewBaseVestingAccount(baseAccount, cosmosMsg.Amount.Sort(), cosmosMsg.EndTime)
+		if cosmosMsg.Delayed {
+			vestingAccount = vestingtypes.NewDelayedVestingAccountRaw(bva)
+		} else {
+			vestingAccount = vestingtypes.NewContinuousVestingAccountRaw(bva, timestamp.Unix())
+		}
 
-func (m *Module) handleMsgCreateVestingAccount(msg *vestingtypes.MsgCreateVestingAccount, txTimestamp time.Time) error {
+	case *vestingtypes.MsgCreatePeriodicVestingAccount:
+		baseAccount, err := newBaseAccount(cosmosMsg.ToAddress)
+		if err != nil {
+			return nil, err
+		}
 
-	accAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
-	if err != nil {
-		return fmt.Errorf("error while converting account address %s", err)
-	}
+		var totalCoins sdk.Coins
+		for _, period := range cosmosMsg.VestingPeriods {
+			totalCoins = totalCoins.Add(period.Amount...)
+		}
 
-	// store account in database
-	err = m.db.SaveAccounts([]types.Account{types.NewAccount(accAddress.String())})
-	if err != nil {
-		return fmt.Errorf("error while storing vesting account: %s", err)
+		vestingAccount = vestingtypes.NewPeriodicVestingAccount(
+			baseAccount, totalCoins.Sort(), cosmosMsg.StartTime, cosmosMsg.VestingPeriods,
+		)
+
+	case *vestingtypes.MsgCreatePermanentLockedAccount:
+		baseAccount, err := newBaseAccount(cosmosMsg.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		vestingAccount = vestingtypes.NewPermanentLockedAccount(baseAccount, cosmosMsg.Amount)
+
+	default:
+		return nil, nil
 	}
 
-	bva := vestingtypes.NewBaseVestingAccount(
-		authttypes.NewBaseAccountWithAddress(accAddress), msg.Amount, msg.EndTime,
-	)
-	err = m.db.StoreBaseVestingAccountFromMsg(bva, txTimestamp)
+	return vestingAccount, nil
+}
+
+// newBaseAccount returns a new base account having the given address
+func newBaseAccount(address string) (*authttypes.BaseAccount, error) {
+	accAddress, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
-		return fmt.Errorf("error while storing base vesting account from msg %s", err)
+		return nil, fmt.Errorf("error while converting account address %s", err)
 	}
-	return nil
+
+	return authttypes.NewBaseAccountWithAddress(accAddress), nil
 }
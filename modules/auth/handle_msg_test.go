@@ -0,0 +1,45 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/auth"
+)
+
+func TestGetCreatedVestingAccount(t *testing.T) {
+	msg := vestingtypes.NewMsgCreatePermanentLockedAccount(
+		sdk.MustAccAddressFromBech32("cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"),
+		sdk.MustAccAddressFromBech32("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2"),
+		uatom(100),
+	)
+	timestamp := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC).Format(time.RFC3339)
+
+	// Failed transactions should not create any vesting account
+	failedTx := &juno.Tx{TxResponse: &sdk.TxResponse{Height: 10, Code: 5, Timestamp: timestamp}}
+	account, err := auth.GetCreatedVestingAccount(msg, failedTx)
+	require.NoError(t, err)
+	require.Nil(t, account)
+
+	successfulTx := &juno.Tx{TxResponse: &sdk.TxResponse{
+		Height:    10,
+		Timestamp: timestamp,
+		Logs:      sdk.ABCIMessageLogs{{MsgIndex: 0}},
+	}}
+	account, err = auth.GetCreatedVestingAccount(msg, successfulTx)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	require.Equal(t, "cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2", account.GetAddress().String())
+	require.Equal(t, uatom(100), account.GetOriginalVesting())
+
+	// Messages that do not create a vesting account should be ignored
+	account, err = auth.GetCreatedVestingAccount(&banktypes.MsgSend{}, successfulTx)
+	require.NoError(t, err)
+	require.Nil(t, account)
+}
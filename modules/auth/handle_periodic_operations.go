@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "auth").Msg("setting up periodic tasks")
+
+	// Update the vesting unlock calendar every day, so that the accounts created meanwhile are included
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.UpdateVestingUnlockCalendar)
+	}); err != nil {
+		return fmt.Errorf("error while setting up auth periodic operation: %s", err)
+	}
+
+	// Update the module accounts every day, as new modules might be added by upgrades
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.UpdateModuleAccounts)
+	}); err != nil {
+		return fmt.Errorf("error while setting up auth periodic operation: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	if tx.AuthInfo == nil {
+		return nil
+	}
+
+	err := m.saveFeesPaid(tx)
+	if err != nil {
+		return fmt.Errorf("error while storing fees paid: %s", err)
+	}
+
+	// The signer infos are sorted the same way as the signers
+	signers := tx.GetSigners()
+
+	var multisigSigners []types.MultisigSigner
+	for index, signerInfo := range tx.AuthInfo.SignerInfos {
+		multiModeInfo := signerInfo.ModeInfo.GetMulti()
+		if multiModeInfo == nil || index >= len(signers) {
+			continue
+		}
+
+		multisigAddress := signers[index].String()
+
+		// Store the multisig account when its public key is available
+		if signerInfo.PublicKey != nil {
+			var pubKey cryptotypes.PubKey
+			err := m.cdc.UnpackAny(signerInfo.PublicKey, &pubKey)
+			if err != nil {
+				return fmt.Errorf("error while unpacking signer public key: %s", err)
+			}
+
+			err = m.saveMultisigPubKey(pubKey, tx.Height)
+			if err != nil {
+				return err
+			}
+		}
+
+		members, err := m.db.GetMultisigAccountMembers(multisigAddress)
+		if err != nil {
+			return fmt.Errorf("error while getting multisig account members: %s", err)
+		}
+
+		// Get the members that have signed the transaction
+		for memberIndex, member := range members {
+			if multiModeInfo.Bitarray.GetIndex(memberIndex) {
+				multisigSigners = append(multisigSigners,
+					types.NewMultisigSigner(tx.TxHash, multisigAddress, member, tx.Height))
+			}
+		}
+	}
+
+	return m.db.SaveMultisigSigners(multisigSigners)
+}
+
+// saveMultisigPubKey stores the multisig account identified by the given public key, if it is a multisig one.
+// Members that are multisig accounts as well are stored too
+func (m *Module) saveMultisigPubKey(pubKey cryptotypes.PubKey, height int64) error {
+	multisigPubKey, ok := pubKey.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return nil
+	}
+
+	for _, member := range multisigPubKey.GetPubKeys() {
+		err := m.saveMultisigPubKey(member, height)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := m.db.SaveMultisigAccount(types.NewMultisigAccount(multisigPubKey, height))
+	if err != nil {
+		return fmt.Errorf("error while storing multisig account %s: %s",
+			sdk.AccAddress(multisigPubKey.Address()).String(), err)
+	}
+
+	return nil
+}
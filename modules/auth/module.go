@@ -4,30 +4,55 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/forbole/bdjuno/v4/database"
+	authsource "github.com/forbole/bdjuno/v4/modules/auth/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 
 	"github.com/forbole/juno/v5/modules"
 	"github.com/forbole/juno/v5/modules/messages"
+	"github.com/forbole/juno/v5/types/config"
 )
 
 var (
-	_ modules.Module        = &Module{}
-	_ modules.GenesisModule = &Module{}
-	_ modules.MessageModule = &Module{}
+	_ modules.Module                     = &Module{}
+	_ modules.GenesisModule              = &Module{}
+	_ modules.MessageModule              = &Module{}
+	_ modules.TransactionModule          = &Module{}
+	_ modules.PeriodicOperationsModule   = &Module{}
+	_ modules.AdditionalOperationsModule = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represents the x/auth module
 type Module struct {
+	cfg            *Config
 	cdc            codec.Codec
 	db             *database.Db
 	messagesParser messages.MessageAddressesParser
+	source         authsource.Source
 }
 
 // NewModule builds a new Module instance
-func NewModule(messagesParser messages.MessageAddressesParser, cdc codec.Codec, db *database.Db) *Module {
+func NewModule(
+	cfg config.Config,
+	source authsource.Source, messagesParser messages.MessageAddressesParser, cdc codec.Codec, db *database.Db,
+) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	authCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
+		cfg:            authCfg,
 		messagesParser: messagesParser,
 		cdc:            cdc,
 		db:             db,
+		source:         source,
 	}
 }
 
@@ -0,0 +1,75 @@
+package local
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/forbole/juno/v5/node/local"
+
+	authsource "github.com/forbole/bdjuno/v4/modules/auth/source"
+)
+
+var (
+	_ authsource.Source = &Source{}
+)
+
+// Source implements authsource.Source using a local node
+type Source struct {
+	*local.Source
+	querier authtypes.QueryServer
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *local.Source, querier authtypes.QueryServer) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Params implements authsource.Source
+func (s Source) Params(height int64) (authtypes.Params, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return authtypes.Params{}, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.Params(sdk.WrapSDKContext(ctx), &authtypes.QueryParamsRequest{})
+	if err != nil {
+		return authtypes.Params{}, err
+	}
+
+	return res.Params, nil
+}
+
+// Account implements authsource.Source
+func (s Source) Account(address string, height int64) (*codectypes.Any, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.Account(sdk.WrapSDKContext(ctx), &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Account, nil
+}
+
+// ModuleAccounts implements authsource.Source
+func (s Source) ModuleAccounts(height int64) ([]*codectypes.Any, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.ModuleAccounts(sdk.WrapSDKContext(ctx), &authtypes.QueryModuleAccountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Accounts, nil
+}
@@ -0,0 +1,63 @@
+package remote
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/forbole/juno/v5/node/remote"
+
+	authsource "github.com/forbole/bdjuno/v4/modules/auth/source"
+)
+
+var (
+	_ authsource.Source = &Source{}
+)
+
+// Source implements authsource.Source using a remote node
+type Source struct {
+	*remote.Source
+	querier authtypes.QueryClient
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, querier authtypes.QueryClient) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Params implements authsource.Source
+func (s Source) Params(height int64) (authtypes.Params, error) {
+	res, err := s.querier.Params(remote.GetHeightRequestContext(s.Ctx, height), &authtypes.QueryParamsRequest{})
+	if err != nil {
+		return authtypes.Params{}, err
+	}
+
+	return res.Params, nil
+}
+
+// Account implements authsource.Source
+func (s Source) Account(address string, height int64) (*codectypes.Any, error) {
+	res, err := s.querier.Account(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&authtypes.QueryAccountRequest{Address: address},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Account, nil
+}
+
+// ModuleAccounts implements authsource.Source
+func (s Source) ModuleAccounts(height int64) ([]*codectypes.Any, error) {
+	res, err := s.querier.ModuleAccounts(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&authtypes.QueryModuleAccountsRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Accounts, nil
+}
@@ -0,0 +1,12 @@
+package source
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type Source interface {
+	Params(height int64) (authtypes.Params, error)
+	Account(address string, height int64) (*codectypes.Any, error)
+	ModuleAccounts(height int64) ([]*codectypes.Any, error)
+}
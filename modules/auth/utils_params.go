@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/rs/zerolog/log"
+
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// UpdateParams gets the updated params and stores them inside the database
+func (m *Module) UpdateParams(height int64) error {
+	log.Debug().Str("module", "auth").Int64("height", height).
+		Msg("updating params")
+
+	params, err := m.source.Params(height)
+	if err != nil {
+		return fmt.Errorf("error while getting params: %s", err)
+	}
+
+	return m.db.SaveAuthParams(types.NewAuthParams(params, height))
+}
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      authtypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&authtypes.MsgUpdateParams{})},
+		Subspaces:   []string{authtypes.ModuleName},
+		Refresh:     m.UpdateParams,
+	}
+}
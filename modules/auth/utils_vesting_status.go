@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/database"
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// VestingAccountFromRows rebuilds the vesting account represented by the given rows
+func VestingAccountFromRows(
+	row dbtypes.VestingAccountRow, periods []dbtypes.VestingPeriodRow,
+) (exported.VestingAccount, error) {
+	accAddress, err := sdk.AccAddressFromBech32(row.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error while converting account address %s", err)
+	}
+
+	baseAccount := authttypes.NewBaseAccountWithAddress(accAddress)
+	originalVesting := row.OriginalVesting.ToCoins()
+
+	var startTime, endTime int64
+	if row.StartTime.Valid {
+		startTime = row.StartTime.Time.Unix()
+	}
+	if row.EndTime.Valid {
+		endTime = row.EndTime.Time.Unix()
+	}
+
+	bva := vestingtypes.NewBaseVestingAccount(baseAccount, originalVesting, endTime)
+
+	switch row.Type {
+	case proto.MessageName(&vestingtypes.ContinuousVestingAccount{}):
+		return vestingtypes.NewContinuousVestingAccountRaw(bva, startTime), nil
+
+	case proto.MessageName(&vestingtypes.DelayedVestingAccount{}):
+		return vestingtypes.NewDelayedVestingAccountRaw(bva), nil
+
+	case proto.MessageName(&vestingtypes.PeriodicVestingAccount{}):
+		vestingPeriods := make(vestingtypes.Periods, len(periods))
+		for i, period := range periods {
+			vestingPeriods[i] = vestingtypes.Period{Length: period.Length, Amount: period.Amount.ToCoins()}
+		}
+		return vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, vestingPeriods), nil
+
+	case proto.MessageName(&vestingtypes.PermanentLockedAccount{}):
+		return vestingtypes.NewPermanentLockedAccount(baseAccount, originalVesting), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported vesting account type: %s", row.Type)
+	}
+}
+
+// ComputeVestingStatus returns the amounts that have been vested and that are still locked
+// inside the given account at the given time
+func ComputeVestingStatus(account exported.VestingAccount, t time.Time) types.VestingStatus {
+	return types.NewVestingStatus(
+		account.GetAddress().String(),
+		t,
+		account.GetOriginalVesting(),
+		account.GetVestedCoins(t),
+		account.GetVestingCoins(t),
+	)
+}
+
+// GetVestingStatus returns the vesting status of the stored account having the given address at the given time.
+// It returns nil if the account is not a stored vesting account
+func GetVestingStatus(db *database.Db, address string, t time.Time) (*types.VestingStatus, error) {
+	row, err := db.GetVestingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if row == nil {
+		return nil, nil
+	}
+
+	periods, err := db.GetVestingPeriods(row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting vesting periods: %s", err)
+	}
+
+	account, err := VestingAccountFromRows(*row, periods)
+	if err != nil {
+		return nil, err
+	}
+
+	status := ComputeVestingStatus(account, t)
+	return &status, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// unlockDays returns the UTC days during which the given account unlocks some tokens
+func unlockDays(account exported.VestingAccount) []time.Time {
+	day := func(timestamp int64) time.Time {
+		return time.Unix(timestamp, 0).UTC().Truncate(24 * time.Hour)
+	}
+
+	switch vestingAccount := account.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		var days []time.Time
+		for d := day(vestingAccount.StartTime); !d.After(day(vestingAccount.EndTime)); d = d.AddDate(0, 0, 1) {
+			days = append(days, d)
+		}
+		return days
+
+	case *vestingtypes.DelayedVestingAccount:
+		return []time.Time{day(vestingAccount.EndTime)}
+
+	case *vestingtypes.PeriodicVestingAccount:
+		var days []time.Time
+		periodEnd := vestingAccount.StartTime
+		for _, period := range vestingAccount.VestingPeriods {
+			periodEnd += period.Length
+			if len(days) == 0 || !days[len(days)-1].Equal(day(periodEnd)) {
+				days = append(days, day(periodEnd))
+			}
+		}
+		return days
+
+	default:
+		// Permanent locked accounts never unlock their tokens
+		return nil
+	}
+}
+
+// ComputeUnlockCalendar returns the amount of tokens unlocked each day by all the given accounts,
+// sorted by date
+func ComputeUnlockCalendar(accounts []exported.VestingAccount) []types.VestingUnlock {
+	unlocked := map[time.Time]sdk.Coins{}
+	for _, account := range accounts {
+		for _, day := range unlockDays(account) {
+			// Consider everything vested in [day, day + 1)
+			before := account.GetVestedCoins(day.Add(-time.Second))
+			after := account.GetVestedCoins(day.AddDate(0, 0, 1).Add(-time.Second))
+
+			amount, hasNeg := after.SafeSub(before...)
+			if hasNeg || amount.IsZero() {
+				continue
+			}
+
+			unlocked[day] = unlocked[day].Add(amount...)
+		}
+	}
+
+	calendar := make([]types.VestingUnlock, 0, len(unlocked))
+	for day, amount := range unlocked {
+		calendar = append(calendar, types.NewVestingUnlock(day, amount))
+	}
+
+	sort.Slice(calendar, func(i, j int) bool {
+		return calendar[i].Date.Before(calendar[j].Date)
+	})
+
+	return calendar
+}
+
+// UpdateVestingUnlockCalendar computes the unlock calendar of all the stored vesting accounts and stores it
+func (m *Module) UpdateVestingUnlockCalendar() error {
+	log.Trace().Str("module", "auth").Str("operation", "vesting unlock calendar").
+		Msg("updating vesting unlock calendar")
+
+	rows, err := m.db.GetVestingAccounts()
+	if err != nil {
+		return fmt.Errorf("error while getting vesting accounts: %s", err)
+	}
+
+	periodRows, err := m.db.GetAllVestingPeriods()
+	if err != nil {
+		return fmt.Errorf("error while getting vesting periods: %s", err)
+	}
+
+	periods := map[int64][]dbtypes.VestingPeriodRow{}
+	for _, period := range periodRows {
+		periods[period.VestingAccountID] = append(periods[period.VestingAccountID], period)
+	}
+
+	accounts := make([]exported.VestingAccount, len(rows))
+	for i, row := range rows {
+		accounts[i], err = VestingAccountFromRows(row, periods[row.ID])
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.db.SaveVestingUnlockCalendar(ComputeUnlockCalendar(accounts))
+}
@@ -0,0 +1,78 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authttypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/auth"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func buildBaseAccount(t *testing.T) *authttypes.BaseAccount {
+	address, err := sdk.AccAddressFromBech32("cosmos1hafptm4zxy5nw8rd2pxyg83c5ls2v62tstzuv2")
+	require.NoError(t, err)
+	return authttypes.NewBaseAccountWithAddress(address)
+}
+
+func uatom(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(amount)))
+}
+
+func TestComputeVestingStatus(t *testing.T) {
+	start := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	end := start.AddDate(0, 0, 10)
+
+	bva := vestingtypes.NewBaseVestingAccount(buildBaseAccount(t), uatom(1000), end.Unix())
+
+	// Continuous vesting account
+	continuous := vestingtypes.NewContinuousVestingAccountRaw(bva, start.Unix())
+	status := auth.ComputeVestingStatus(continuous, start.AddDate(0, 0, 4))
+	require.Equal(t, uatom(400), status.Vested)
+	require.Equal(t, uatom(600), status.Locked)
+
+	// Delayed vesting account
+	delayed := vestingtypes.NewDelayedVestingAccountRaw(bva)
+	status = auth.ComputeVestingStatus(delayed, start.AddDate(0, 0, 4))
+	require.True(t, status.Vested.IsZero())
+	require.Equal(t, uatom(1000), status.Locked)
+
+	status = auth.ComputeVestingStatus(delayed, end)
+	require.Equal(t, uatom(1000), status.Vested)
+	require.True(t, status.Locked.IsZero())
+
+	// Permanent locked account
+	locked := vestingtypes.NewPermanentLockedAccount(buildBaseAccount(t), uatom(1000))
+	status = auth.ComputeVestingStatus(locked, end.AddDate(10, 0, 0))
+	require.True(t, status.Vested.IsZero())
+	require.Equal(t, uatom(1000), status.Locked)
+}
+
+func TestComputeUnlockCalendar(t *testing.T) {
+	start := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+	day := int64(24 * 60 * 60)
+
+	periodic := vestingtypes.NewPeriodicVestingAccount(buildBaseAccount(t), uatom(300), start.Unix(), vestingtypes.Periods{
+		{Length: day, Amount: uatom(100)},
+		{Length: 2 * day, Amount: uatom(200)},
+	})
+
+	continuous := vestingtypes.NewContinuousVestingAccountRaw(
+		vestingtypes.NewBaseVestingAccount(buildBaseAccount(t), uatom(20), start.Unix()+2*day),
+		start.Unix(),
+	)
+
+	locked := vestingtypes.NewPermanentLockedAccount(buildBaseAccount(t), uatom(1000))
+
+	calendar := auth.ComputeUnlockCalendar([]exported.VestingAccount{periodic, continuous, locked})
+	require.Equal(t, []types.VestingUnlock{
+		types.NewVestingUnlock(start, uatom(10)),
+		types.NewVestingUnlock(start.AddDate(0, 0, 1), uatom(110)),
+		types.NewVestingUnlock(start.AddDate(0, 0, 3), uatom(200)),
+	}, calendar)
+}
@@ -0,0 +1,107 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleMsgExec implements modules.AuthzMessageModule
+func (m *Module) HandleMsgExec(index int, msgExec *authztypes.MsgExec, _ int, executedMsg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	err := m.HandleMsg(index, executedMsg, tx)
+	if err != nil {
+		return err
+	}
+
+	// Messages signed by the grantee itself are executed without using any grant
+	signers := executedMsg.GetSigners()
+	if len(signers) != 1 || signers[0].String() == msgExec.Grantee {
+		return nil
+	}
+
+	// Executing a message can update the grant (eg. its spend limit) or remove it once used up
+	return m.RefreshGrant(signers[0].String(), msgExec.Grantee, sdk.MsgTypeURL(executedMsg), tx.Height)
+}
+
+// HandleMsg implements modules.MessageModule
+func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+	if len(tx.Logs) == 0 {
+		return nil
+	}
+
+	switch cosmosMsg := msg.(type) {
+	case *authztypes.MsgGrant:
+		return m.HandleMsgGrant(tx, cosmosMsg)
+	case *authztypes.MsgRevoke:
+		return m.HandleMsgRevoke(tx, cosmosMsg)
+	}
+
+	return nil
+}
+
+// HandleMsgGrant allows to properly handle a MsgGrant
+func (m *Module) HandleMsgGrant(tx *juno.Tx, msg *authztypes.MsgGrant) error {
+	grant, msgTypeURL, err := m.unpackGrant(msg.Grant.Authorization, msg.Grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveAuthzGrant(types.NewAuthzGrant(msg.Granter, msg.Grantee, msgTypeURL, grant, tx.Height))
+}
+
+// HandleMsgRevoke allows to properly handle a MsgRevoke
+func (m *Module) HandleMsgRevoke(tx *juno.Tx, msg *authztypes.MsgRevoke) error {
+	return m.db.DeleteAuthzGrant(types.NewAuthzGrantRemoval(msg.Granter, msg.Grantee, msg.MsgTypeUrl, tx.Height))
+}
+
+// RefreshGrant gets the grant given by the granter to the grantee for the given message type at the given height
+// and stores it inside the database, removing it if it no longer exists on chain
+func (m *Module) RefreshGrant(granter string, grantee string, msgTypeURL string, height int64) error {
+	grants, err := m.source.Grants(granter, grantee, height)
+	if err != nil {
+		return fmt.Errorf("error while getting authz grants: %s", err)
+	}
+
+	for _, grant := range grants {
+		authzGrant, grantMsgTypeURL, err := m.unpackGrant(grant.Authorization, grant.Expiration)
+		if err != nil {
+			return err
+		}
+
+		if grantMsgTypeURL == msgTypeURL {
+			return m.db.SaveAuthzGrant(types.NewAuthzGrant(granter, grantee, msgTypeURL, authzGrant, height))
+		}
+	}
+
+	return m.db.DeleteAuthzGrant(types.NewAuthzGrantRemoval(granter, grantee, msgTypeURL, height))
+}
+
+// unpackGrant rebuilds a grant unpacking the given authorization, so that it can be serialized.
+// It returns the grant along with the type URL of the messages it authorizes
+func (m *Module) unpackGrant(
+	authorizationAny *codectypes.Any, expiration *time.Time,
+) (authztypes.Grant, string, error) {
+	var authorization authztypes.Authorization
+	err := m.cdc.UnpackAny(authorizationAny, &authorization)
+	if err != nil {
+		return authztypes.Grant{}, "", fmt.Errorf("error while unpacking authz grant authorization: %s", err)
+	}
+
+	authorizationAny, err = codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return authztypes.Grant{}, "", fmt.Errorf("error while packing authz grant authorization: %s", err)
+	}
+
+	grant := authztypes.Grant{Authorization: authorizationAny, Expiration: expiration}
+	return grant, authorization.MsgTypeURL(), nil
+}
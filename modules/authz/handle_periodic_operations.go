@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "authz").Msg("setting up periodic tasks")
+
+	// Mark the expired grants every 5 minutes
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(m.SweepExpiredGrants)
+	}); err != nil {
+		return fmt.Errorf("error while setting up authz periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// SweepExpiredGrants marks as expired all the grants having an expiration not after the latest block time.
+// The chain prunes the expired grants without emitting any event, so they need to be detected this way
+func (m *Module) SweepExpiredGrants() error {
+	log.Trace().Str("module", "authz").Str("operation", "expired grants").
+		Msg("marking expired authz grants")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	count, err := m.db.SetAuthzGrantsExpired(block.BlockTimestamp)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().Str("module", "authz").Int64("height", block.Height).Int64("count", count).
+		Msg("marked expired authz grants")
+	return nil
+}
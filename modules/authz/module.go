@@ -0,0 +1,38 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/forbole/bdjuno/v4/database"
+	authzsource "github.com/forbole/bdjuno/v4/modules/authz/source"
+
+	"github.com/forbole/juno/v5/modules"
+)
+
+var (
+	_ modules.Module                   = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.AuthzMessageModule       = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
+)
+
+// Module represent x/authz module
+type Module struct {
+	cdc    codec.Codec
+	db     *database.Db
+	source authzsource.Source
+}
+
+// NewModule returns a new Module instance
+func NewModule(source authzsource.Source, cdc codec.Codec, db *database.Db) *Module {
+	return &Module{
+		cdc:    cdc,
+		db:     db,
+		source: source,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "authz"
+}
@@ -0,0 +1,97 @@
+package local
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/forbole/juno/v5/node/local"
+
+	authzsource "github.com/forbole/bdjuno/v4/modules/authz/source"
+)
+
+var (
+	_ authzsource.Source = &Source{}
+)
+
+// Source implements authzsource.Source using a local node
+type Source struct {
+	*local.Source
+	querier authztypes.QueryServer
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *local.Source, querier authztypes.QueryServer) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Grants implements authzsource.Source
+func (s Source) Grants(granter string, grantee string, height int64) ([]*authztypes.Grant, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	var grants []*authztypes.Grant
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.querier.Grants(
+			sdk.WrapSDKContext(ctx),
+			&authztypes.QueryGrantsRequest{
+				Granter: granter,
+				Grantee: grantee,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 grants at a time
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		grants = append(grants, res.Grants...)
+	}
+
+	return grants, nil
+}
+
+// GranterGrants implements authzsource.Source
+func (s Source) GranterGrants(granter string, height int64) ([]*authztypes.GrantAuthorization, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	var grants []*authztypes.GrantAuthorization
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.querier.GranterGrants(
+			sdk.WrapSDKContext(ctx),
+			&authztypes.QueryGranterGrantsRequest{
+				Granter: granter,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 grants at a time
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		grants = append(grants, res.Grants...)
+	}
+
+	return grants, nil
+}
@@ -0,0 +1,88 @@
+package remote
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/forbole/juno/v5/node/remote"
+
+	authzsource "github.com/forbole/bdjuno/v4/modules/authz/source"
+)
+
+var (
+	_ authzsource.Source = &Source{}
+)
+
+// Source implements authzsource.Source using a remote node
+type Source struct {
+	*remote.Source
+	querier authztypes.QueryClient
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, querier authztypes.QueryClient) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Grants implements authzsource.Source
+func (s Source) Grants(granter string, grantee string, height int64) ([]*authztypes.Grant, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	var grants []*authztypes.Grant
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.querier.Grants(
+			ctx,
+			&authztypes.QueryGrantsRequest{
+				Granter: granter,
+				Grantee: grantee,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 grants at a time
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		grants = append(grants, res.Grants...)
+	}
+
+	return grants, nil
+}
+
+// GranterGrants implements authzsource.Source
+func (s Source) GranterGrants(granter string, height int64) ([]*authztypes.GrantAuthorization, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	var grants []*authztypes.GrantAuthorization
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.querier.GranterGrants(
+			ctx,
+			&authztypes.QueryGranterGrantsRequest{
+				Granter: granter,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 grants at a time
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		grants = append(grants, res.Grants...)
+	}
+
+	return grants, nil
+}
@@ -0,0 +1,10 @@
+package source
+
+import (
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type Source interface {
+	Grants(granter string, grantee string, height int64) ([]*authztypes.Grant, error)
+	GranterGrants(granter string, height int64) ([]*authztypes.GrantAuthorization, error)
+}
@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// ReconcileGranterGrants compares the authz grants stored for the given granter against the ones
+// that exist on chain at the given height. Grants that are missing or outdated are stored again,
+// while the ones that no longer exist on chain are removed unless they have been marked as expired.
+// Grants expiring at or before the given block time are kept marked as expired, even if the chain has not pruned them yet.
+// The expired grants should be swept before calling this method. It returns the number of grants that have been fixed
+func (m *Module) ReconcileGranterGrants(granter string, height int64, blockTime time.Time) (int, error) {
+	log.Debug().Str("module", "authz").Str("granter", granter).Int64("height", height).
+		Msg("reconciling authz grants")
+
+	grants, err := m.source.GranterGrants(granter, height)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting authz grants: %s", err)
+	}
+
+	rows, err := m.db.GetAuthzGrantsByGranter(granter)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting stored authz grants: %s", err)
+	}
+
+	stored := make(map[string]dbtypes.AuthzGrantRow, len(rows))
+	for _, row := range rows {
+		stored[grantKey(row.Grantee, row.MsgTypeURL)] = row
+	}
+
+	fixed := 0
+	foundExpired := false
+	onChain := make(map[string]bool, len(grants))
+	for _, grantAuthorization := range grants {
+		grant, msgTypeURL, err := m.unpackGrant(grantAuthorization.Authorization, grantAuthorization.Expiration)
+		if err != nil {
+			return fixed, err
+		}
+
+		key := grantKey(grantAuthorization.Grantee, msgTypeURL)
+		onChain[key] = true
+
+		row, isStored := stored[key]
+		if isStored && row.Expiration.Valid && !row.Expiration.Time.After(blockTime) {
+			// The grant has expired but has not been pruned yet, so storing it again would un-expire it
+			continue
+		}
+
+		if !isStored {
+			log.Info().Str("module", "authz").Str("granter", granter).Str("grantee", grantAuthorization.Grantee).
+				Str("msg_type_url", msgTypeURL).Msg("found authz grant missing from the database")
+			fixed++
+
+			expired := grant.Expiration != nil && !grant.Expiration.After(blockTime)
+			foundExpired = foundExpired || expired
+		}
+
+		// Store the grant anyway so that its authorization is up to date
+		err = m.db.SaveAuthzGrant(types.NewAuthzGrant(granter, grantAuthorization.Grantee, msgTypeURL, grant, height))
+		if err != nil {
+			return fixed, err
+		}
+	}
+
+	// Missing grants are stored as not expired, so they need to be marked if they have expired already
+	if foundExpired {
+		_, err = m.db.SetAuthzGrantsExpired(blockTime)
+		if err != nil {
+			return fixed, err
+		}
+	}
+
+	for _, row := range rows {
+		// Expired grants are pruned by the chain, but we keep them marked as expired
+		if onChain[grantKey(row.Grantee, row.MsgTypeURL)] || row.Expired {
+			continue
+		}
+
+		log.Info().Str("module", "authz").Str("granter", granter).Str("grantee", row.Grantee).
+			Str("msg_type_url", row.MsgTypeURL).Msg("found authz grant no longer existing on chain")
+		fixed++
+
+		err = m.db.DeleteAuthzGrant(types.NewAuthzGrantRemoval(granter, row.Grantee, row.MsgTypeURL, height))
+		if err != nil {
+			return fixed, err
+		}
+	}
+
+	return fixed, nil
+}
+
+// grantKey returns the key identifying the grant given to the grantee for the given message type
+func grantKey(grantee string, msgTypeURL string) string {
+	return grantee + "/" + msgTypeURL
+}
@@ -0,0 +1,48 @@
+package bank
+
+import (
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, blockResults *tmctypes.ResultBlockResults, txs []*juno.Tx, _ *tmctypes.ResultValidators,
+) error {
+	err := m.updateSupplyChanges(b.Block.Height, blockResults, txs)
+	if err != nil {
+		log.Error().Str("module", "bank").Int64("height", b.Block.Height).
+			Err(err).Msg("error while updating supply changes")
+	}
+
+	return nil
+}
+
+// updateSupplyChanges stores all the coins minted and burned inside the block having the given height.
+// Events are read in execution order (BeginBlock, transactions, EndBlock) so that each change gets a stable index.
+func (m *Module) updateSupplyChanges(height int64, blockResults *tmctypes.ResultBlockResults, txs []*juno.Tx) error {
+	parser := NewSupplyChangesParser(height)
+
+	err := parser.Parse(sdk.StringifyEvents(blockResults.BeginBlockEvents), "")
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		for _, msgLog := range tx.Logs {
+			err = parser.Parse(msgLog.Events, tx.TxHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = parser.Parse(sdk.StringifyEvents(blockResults.EndBlockEvents), "")
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveSupplyChanges(parser.Changes())
+}
@@ -0,0 +1,32 @@
+package bank
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleGenesis implements modules.GenesisModule
+func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json.RawMessage) error {
+	log.Debug().Str("module", "bank").Msg("parsing genesis")
+
+	// Read the genesis state
+	var genState banktypes.GenesisState
+	err := m.cdc.UnmarshalJSON(appState[banktypes.ModuleName], &genState)
+	if err != nil {
+		return fmt.Errorf("error while reading bank genesis data: %s", err)
+	}
+
+	// Save the params
+	err = m.db.SaveBankParams(types.NewBankParams(genState.Params, doc.InitialHeight))
+	if err != nil {
+		return fmt.Errorf("error while storing genesis bank params: %s", err)
+	}
+
+	return nil
+}
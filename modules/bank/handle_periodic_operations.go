@@ -19,6 +19,13 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while setting up bank periodic operation: %s", err)
 	}
 
+	// Reconcile the supply of the previous day every midnight
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.ReconcileYesterdaySupply)
+	}); err != nil {
+		return fmt.Errorf("error while setting up bank periodic operation: %s", err)
+	}
+
 	return nil
 }
 
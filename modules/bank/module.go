@@ -5,6 +5,7 @@ import (
 
 	"github.com/forbole/bdjuno/v4/database"
 	"github.com/forbole/bdjuno/v4/modules/bank/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 
 	junomessages "github.com/forbole/juno/v5/modules/messages"
 
@@ -13,7 +14,11 @@ import (
 
 var (
 	_ modules.Module                   = &Module{}
+	_ modules.GenesisModule            = &Module{}
+	_ modules.BlockModule              = &Module{}
 	_ modules.PeriodicOperationsModule = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represents the x/bank module
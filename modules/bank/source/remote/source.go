@@ -77,3 +77,15 @@ func (s Source) GetSupply(height int64) (sdk.Coins, error) {
 
 	return coins, nil
 }
+
+// GetParams implements bankkeeper.Source
+func (s Source) GetParams(height int64) (banktypes.Params, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	res, err := s.bankClient.Params(ctx, &banktypes.QueryParamsRequest{})
+	if err != nil {
+		return banktypes.Params{}, fmt.Errorf("error while getting params: %s", err)
+	}
+
+	return res.Params, nil
+}
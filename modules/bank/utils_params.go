@@ -0,0 +1,35 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/rs/zerolog/log"
+
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// UpdateParams gets the updated params and stores them inside the database
+func (m *Module) UpdateParams(height int64) error {
+	log.Debug().Str("module", "bank").Int64("height", height).
+		Msg("updating params")
+
+	params, err := m.keeper.GetParams(height)
+	if err != nil {
+		return fmt.Errorf("error while getting params: %s", err)
+	}
+
+	return m.db.SaveBankParams(types.NewBankParams(params, height))
+}
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      banktypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&banktypes.MsgUpdateParams{})},
+		Subspaces:   []string{banktypes.ModuleName},
+		Refresh:     m.UpdateParams,
+	}
+}
@@ -0,0 +1,111 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// ibcTransferModuleName is the name of the IBC transfer module, which mints and burns the vouchers of IBC tokens
+const ibcTransferModuleName = "transfer"
+
+var (
+	mintAddress          = authtypes.NewModuleAddress(minttypes.ModuleName).String()
+	govAddress           = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	bondedPoolAddress    = authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPoolAddress = authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+
+	// knownModuleAddresses contains the addresses of the module accounts that can mint or burn coins
+	knownModuleAddresses = map[string]bool{
+		authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(): true,
+		authtypes.NewModuleAddress(distrtypes.ModuleName).String():      true,
+		authtypes.NewModuleAddress(ibcTransferModuleName).String():      true,
+		mintAddress:          true,
+		govAddress:           true,
+		bondedPoolAddress:    true,
+		notBondedPoolAddress: true,
+	}
+)
+
+// SupplyChangesParser allows to parse the coinbase and burn events of a block into supply changes
+type SupplyChangesParser struct {
+	height  int64
+	changes []types.SupplyChange
+}
+
+// NewSupplyChangesParser returns a new SupplyChangesParser for the block having the given height
+func NewSupplyChangesParser(height int64) *SupplyChangesParser {
+	return &SupplyChangesParser{
+		height: height,
+	}
+}
+
+// Changes returns all the supply changes parsed so far
+func (p *SupplyChangesParser) Changes() []types.SupplyChange {
+	return p.changes
+}
+
+// Parse parses the given events, emitted inside the transaction having the given hash
+// (or by the BeginBlock and EndBlock if empty), appending the resulting supply changes.
+// Since events of the same type might be merged together, each amount attribute is
+// associated to the minter or burner attribute that precedes it.
+func (p *SupplyChangesParser) Parse(events sdk.StringEvents, txHash string) error {
+	for _, event := range events {
+		var changeType, addressKey string
+		switch event.Type {
+		case banktypes.EventTypeCoinMint:
+			changeType, addressKey = types.SupplyChangeTypeMint, banktypes.AttributeKeyMinter
+		case banktypes.EventTypeCoinBurn:
+			changeType, addressKey = types.SupplyChangeTypeBurn, banktypes.AttributeKeyBurner
+		default:
+			continue
+		}
+
+		var address string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addressKey:
+				address = attr.Value
+
+			case sdk.AttributeKeyAmount:
+				coins, err := sdk.ParseCoinsNormalized(attr.Value)
+				if err != nil {
+					return fmt.Errorf("error while parsing %s amount: %s", event.Type, err)
+				}
+
+				origin := getSupplyChangeOrigin(changeType, address)
+				for _, coin := range coins {
+					p.changes = append(p.changes, types.NewSupplyChange(
+						changeType, origin, address, coin.Denom, coin.Amount, txHash, len(p.changes), p.height,
+					))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// getSupplyChangeOrigin returns the origin of a supply change of the given type performed by the given address
+func getSupplyChangeOrigin(changeType string, address string) string {
+	switch {
+	case changeType == types.SupplyChangeTypeMint && address == mintAddress:
+		return types.SupplyChangeOriginInflation
+	case changeType == types.SupplyChangeTypeBurn && (address == bondedPoolAddress || address == notBondedPoolAddress):
+		return types.SupplyChangeOriginSlashing
+	case changeType == types.SupplyChangeTypeBurn && address == govAddress:
+		return types.SupplyChangeOriginGovDeposit
+	case knownModuleAddresses[address]:
+		return types.SupplyChangeOriginModule
+	default:
+		return types.SupplyChangeOriginCustom
+	}
+}
@@ -0,0 +1,103 @@
+package bank
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// ReconcileYesterdaySupply reconciles the supply delta of the previous UTC day
+func (m *Module) ReconcileYesterdaySupply() error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	return m.ReconcileSupply(today.Add(-24 * time.Hour))
+}
+
+// ReconcileSupply compares the supply delta of each denom during the given UTC day with the amounts
+// minted and burned during the same day, storing the result so that unexplained differences can be found
+func (m *Module) ReconcileSupply(date time.Time) error {
+	date = date.UTC().Truncate(24 * time.Hour)
+
+	log.Debug().Str("module", "bank").Time("date", date).Msg("reconciling supply")
+
+	start, err := m.db.GetSupplyBefore(date)
+	if err != nil {
+		return err
+	}
+
+	end, err := m.db.GetSupplyBefore(date.Add(24 * time.Hour))
+	if err != nil {
+		return err
+	}
+
+	if start == nil || end == nil || start.Height == end.Height {
+		log.Debug().Str("module", "bank").Time("date", date).Msg("not enough supply history to reconcile")
+		return nil
+	}
+
+	totals, err := m.db.GetSupplyChangesTotals(start.Height, end.Height)
+	if err != nil {
+		return err
+	}
+
+	minted := map[string]sdkmath.Int{}
+	burned := map[string]sdkmath.Int{}
+	for _, total := range totals {
+		amount, ok := sdk.NewIntFromString(total.Amount)
+		if !ok {
+			return fmt.Errorf("invalid supply change amount: %s", total.Amount)
+		}
+
+		switch total.Type {
+		case types.SupplyChangeTypeMint:
+			minted[total.Denom] = amount
+		case types.SupplyChangeTypeBurn:
+			burned[total.Denom] = amount
+		}
+	}
+
+	startSupply := start.Coins.ToCoins()
+	endSupply := end.Coins.ToCoins()
+
+	denoms := map[string]bool{}
+	for _, coin := range startSupply.Add(endSupply...) {
+		denoms[coin.Denom] = true
+	}
+	for denom := range minted {
+		denoms[denom] = true
+	}
+	for denom := range burned {
+		denoms[denom] = true
+	}
+
+	var reconciliations []types.SupplyReconciliation
+	for denom := range denoms {
+		reconciliation := types.NewSupplyReconciliation(
+			date, denom, start.Height, end.Height,
+			endSupply.AmountOf(denom).Sub(startSupply.AmountOf(denom)),
+			getIntOrZero(minted, denom),
+			getIntOrZero(burned, denom),
+		)
+
+		if !reconciliation.Unexplained().IsZero() {
+			log.Warn().Str("module", "bank").Time("date", date).Str("denom", denom).
+				Str("unexplained", reconciliation.Unexplained().String()).Msg("unexplained supply change")
+		}
+
+		reconciliations = append(reconciliations, reconciliation)
+	}
+
+	return m.db.SaveSupplyReconciliations(reconciliations)
+}
+
+// getIntOrZero returns the value associated to the given key, or zero if not found
+func getIntOrZero(values map[string]sdkmath.Int, key string) sdkmath.Int {
+	if value, ok := values[key]; ok {
+		return value
+	}
+	return sdkmath.ZeroInt()
+}
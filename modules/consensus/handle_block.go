@@ -12,7 +12,7 @@ import (
 
 // HandleBlock implements modules.Module
 func (m *Module) HandleBlock(
-	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, _ []*types.Tx, _ *tmctypes.ResultValidators,
+	b *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, txs []*types.Tx, _ *tmctypes.ResultValidators,
 ) error {
 	err := m.updateBlockTimeFromGenesis(b)
 	if err != nil {
@@ -20,7 +20,20 @@ func (m *Module) HandleBlock(
 			Err(err).Msg("error while updating block time from genesis")
 	}
 
-	return nil
+	return m.updateBlockGasStats(b.Block.Height, txs)
+}
+
+// updateBlockGasStats stores the gas usage and gas prices stats of the block having the given height
+func (m *Module) updateBlockGasStats(height int64, txs []*types.Tx) error {
+	log.Trace().Str("module", "consensus").Int64("height", height).
+		Msg("updating block gas stats")
+
+	err := m.db.SaveBlockGasUsage(GetGasUsage(height, txs))
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveBlockGasPrices(height, GetGasPrices(txs))
 }
 
 // updateBlockTimeFromGenesis insert average block time from genesis
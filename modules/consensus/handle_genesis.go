@@ -20,5 +20,13 @@ func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, _ map[string]json.RawMes
 		return fmt.Errorf("error while storing genesis time: %s", err)
 	}
 
+	// Save the consensus params
+	if doc.ConsensusParams != nil {
+		err = m.db.SaveConsensusParams(types.NewConsensusParams(doc.ConsensusParams.ToProto(), doc.InitialHeight))
+		if err != nil {
+			return fmt.Errorf("error while storing genesis consensus params: %s", err)
+		}
+	}
+
 	return nil
 }
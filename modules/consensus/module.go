@@ -2,6 +2,8 @@ package consensus
 
 import (
 	"github.com/forbole/bdjuno/v4/database"
+	consensussource "github.com/forbole/bdjuno/v4/modules/consensus/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 
 	"github.com/forbole/juno/v5/modules"
 )
@@ -11,17 +13,21 @@ var (
 	_ modules.PeriodicOperationsModule = &Module{}
 	_ modules.GenesisModule            = &Module{}
 	_ modules.BlockModule              = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module implements the consensus utils
 type Module struct {
-	db *database.Db
+	db     *database.Db
+	source consensussource.Source
 }
 
 // NewModule builds a new Module instance
-func NewModule(db *database.Db) *Module {
+func NewModule(source consensussource.Source, db *database.Db) *Module {
 	return &Module{
-		db: db,
+		db:     db,
+		source: source,
 	}
 }
 
@@ -0,0 +1,49 @@
+package local
+
+import (
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/forbole/juno/v5/node/local"
+
+	consensussource "github.com/forbole/bdjuno/v4/modules/consensus/source"
+)
+
+var (
+	_ consensussource.Source = &Source{}
+)
+
+// Source implements consensussource.Source using a local node
+type Source struct {
+	*local.Source
+	querier consensustypes.QueryServer
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *local.Source, querier consensustypes.QueryServer) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Params implements consensussource.Source
+func (s Source) Params(height int64) (cmtproto.ConsensusParams, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return cmtproto.ConsensusParams{}, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.Params(sdk.WrapSDKContext(ctx), &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+
+	if res.Params == nil {
+		return cmtproto.ConsensusParams{}, fmt.Errorf("consensus params not found")
+	}
+
+	return *res.Params, nil
+}
@@ -0,0 +1,43 @@
+package remote
+
+import (
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/forbole/juno/v5/node/remote"
+
+	consensussource "github.com/forbole/bdjuno/v4/modules/consensus/source"
+)
+
+var (
+	_ consensussource.Source = &Source{}
+)
+
+// Source implements consensussource.Source using a remote node
+type Source struct {
+	*remote.Source
+	querier consensustypes.QueryClient
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, querier consensustypes.QueryClient) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Params implements consensussource.Source
+func (s Source) Params(height int64) (cmtproto.ConsensusParams, error) {
+	res, err := s.querier.Params(remote.GetHeightRequestContext(s.Ctx, height), &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+
+	if res.Params == nil {
+		return cmtproto.ConsensusParams{}, fmt.Errorf("consensus params not found")
+	}
+
+	return *res.Params, nil
+}
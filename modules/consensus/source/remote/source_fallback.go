@@ -0,0 +1,38 @@
+package remote
+
+import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	consensussource "github.com/forbole/bdjuno/v4/modules/consensus/source"
+)
+
+var (
+	_ consensussource.Source = &FallbackSource{}
+)
+
+// FallbackSource implements consensussource.Source by querying the primary source first, and the fallback
+// one when the node replies that the primary queries are not implemented.
+// This allows to index heights before the x/consensus module was introduced
+type FallbackSource struct {
+	primary  consensussource.Source
+	fallback consensussource.Source
+}
+
+// NewFallbackSource returns a new FallbackSource instance
+func NewFallbackSource(primary consensussource.Source, fallback consensussource.Source) *FallbackSource {
+	return &FallbackSource{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+// Params implements consensussource.Source
+func (s FallbackSource) Params(height int64) (cmtproto.ConsensusParams, error) {
+	res, err := s.primary.Params(height)
+	if status.Code(err) == codes.Unimplemented {
+		return s.fallback.Params(height)
+	}
+	return res, err
+}
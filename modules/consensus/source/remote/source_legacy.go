@@ -0,0 +1,92 @@
+package remote
+
+import (
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/forbole/juno/v5/node/remote"
+
+	consensussource "github.com/forbole/bdjuno/v4/modules/consensus/source"
+)
+
+var (
+	_ consensussource.Source = &LegacySource{}
+)
+
+// LegacySource implements consensussource.Source by reading the consensus params from the legacy
+// x/params baseapp subspace, where they were stored before the x/consensus module was introduced
+type LegacySource struct {
+	*remote.Source
+	querier proposaltypes.QueryClient
+	amino   *codec.LegacyAmino
+}
+
+// NewLegacySource returns a new LegacySource instance
+func NewLegacySource(source *remote.Source, querier proposaltypes.QueryClient) *LegacySource {
+	return &LegacySource{
+		Source:  source,
+		querier: querier,
+		amino:   codec.NewLegacyAmino(),
+	}
+}
+
+// Params implements consensussource.Source
+func (s LegacySource) Params(height int64) (cmtproto.ConsensusParams, error) {
+	var params cmtproto.ConsensusParams
+
+	var block cmtproto.BlockParams
+	found, err := s.getParam(height, baseapp.ParamStoreKeyBlockParams, &block)
+	if err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+	if found {
+		params.Block = &block
+	}
+
+	var evidence cmtproto.EvidenceParams
+	found, err = s.getParam(height, baseapp.ParamStoreKeyEvidenceParams, &evidence)
+	if err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+	if found {
+		params.Evidence = &evidence
+	}
+
+	var validator cmtproto.ValidatorParams
+	found, err = s.getParam(height, baseapp.ParamStoreKeyValidatorParams, &validator)
+	if err != nil {
+		return cmtproto.ConsensusParams{}, err
+	}
+	if found {
+		params.Validator = &validator
+	}
+
+	return params, nil
+}
+
+// getParam reads the value of the given key from the baseapp subspace at the given height,
+// returning false if the key has not been set
+func (s LegacySource) getParam(height int64, key []byte, ptr interface{}) (bool, error) {
+	res, err := s.querier.Params(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&proposaltypes.QueryParamsRequest{Subspace: baseapp.Paramspace, Key: string(key)},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if res.Param.Value == "" {
+		return false, nil
+	}
+
+	// The x/params module stores the values using the legacy amino JSON encoding
+	err = s.amino.UnmarshalJSON([]byte(res.Param.Value), ptr)
+	if err != nil {
+		return false, fmt.Errorf("error while unmarshalling %s params: %s", key, err)
+	}
+
+	return true, nil
+}
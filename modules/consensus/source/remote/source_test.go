@@ -0,0 +1,107 @@
+package remote_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/forbole/juno/v5/node/remote"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	remoteconsensussource "github.com/forbole/bdjuno/v4/modules/consensus/source/remote"
+)
+
+// fakeParamsServer implements proposaltypes.QueryServer serving the given legacy baseapp params
+type fakeParamsServer struct {
+	proposaltypes.UnimplementedQueryServer
+	params map[string]interface{}
+}
+
+func (s *fakeParamsServer) Params(
+	_ context.Context, req *proposaltypes.QueryParamsRequest,
+) (*proposaltypes.QueryParamsResponse, error) {
+	if req.Subspace != baseapp.Paramspace {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown subspace %s", req.Subspace)
+	}
+
+	value, ok := s.params[req.Key]
+	if !ok {
+		return &proposaltypes.QueryParamsResponse{
+			Param: proposaltypes.NewParamChange(req.Subspace, req.Key, ""),
+		}, nil
+	}
+
+	bz, err := codec.NewLegacyAmino().MarshalJSON(value)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proposaltypes.QueryParamsResponse{
+		Param: proposaltypes.NewParamChange(req.Subspace, req.Key, string(bz)),
+	}, nil
+}
+
+// fakeConsensusServer implements consensustypes.QueryServer without implementing any query,
+// as a node that does not have the x/consensus module would do
+type fakeConsensusServer struct {
+	consensustypes.UnimplementedQueryServer
+}
+
+// buildSource starts a fake gRPC server that serves only the legacy params queries,
+// and returns a fallback source connected to it
+func buildSource(t *testing.T, params map[string]interface{}) *remoteconsensussource.FallbackSource {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	consensustypes.RegisterQueryServer(server, &fakeConsensusServer{})
+	proposaltypes.RegisterQueryServer(server, &fakeParamsServer{params: params})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	source := &remote.Source{Ctx: context.Background(), GrpcConn: conn}
+	return remoteconsensussource.NewFallbackSource(
+		remoteconsensussource.NewSource(source, consensustypes.NewQueryClient(conn)),
+		remoteconsensussource.NewLegacySource(source, proposaltypes.NewQueryClient(conn)),
+	)
+}
+
+func TestFallbackSource_Params(t *testing.T) {
+	block := cmtproto.BlockParams{MaxBytes: 22020096, MaxGas: -1}
+	evidence := cmtproto.EvidenceParams{MaxAgeNumBlocks: 100000, MaxAgeDuration: 48 * time.Hour, MaxBytes: 1048576}
+
+	source := buildSource(t, map[string]interface{}{
+		string(baseapp.ParamStoreKeyBlockParams):    block,
+		string(baseapp.ParamStoreKeyEvidenceParams): evidence,
+	})
+
+	params, err := source.Params(10)
+	require.NoError(t, err)
+	require.Equal(t, &block, params.Block)
+	require.Equal(t, &evidence, params.Evidence)
+
+	// Params that have never been set should be left empty
+	require.Nil(t, params.Validator)
+}
@@ -0,0 +1,9 @@
+package source
+
+import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+type Source interface {
+	Params(height int64) (cmtproto.ConsensusParams, error)
+}
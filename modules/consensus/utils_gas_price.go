@@ -0,0 +1,88 @@
+package consensus
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+var (
+	medianPercentile = sdk.NewDecWithPrec(5, 1)
+	p90Percentile    = sdk.NewDecWithPrec(9, 1)
+)
+
+// GetGasPrices returns the stats of the gas prices paid by the given transactions, grouped by fee denom
+// and sorted by denom. The gas price of a transaction is given by its fee amount divided by its gas wanted
+func GetGasPrices(txs []*juno.Tx) []types.GasPrice {
+	gasPrices := map[string][]sdk.Dec{}
+	totalFees := map[string]sdkmath.Int{}
+	for _, tx := range txs {
+		if tx.AuthInfo == nil || tx.AuthInfo.Fee == nil || tx.GasWanted <= 0 {
+			continue
+		}
+
+		for _, coin := range tx.AuthInfo.Fee.Amount {
+			gasPrice := sdk.NewDecFromInt(coin.Amount).QuoInt64(tx.GasWanted)
+			gasPrices[coin.Denom] = append(gasPrices[coin.Denom], gasPrice)
+
+			total, ok := totalFees[coin.Denom]
+			if !ok {
+				total = sdkmath.ZeroInt()
+			}
+			totalFees[coin.Denom] = total.Add(coin.Amount)
+		}
+	}
+
+	denoms := make([]string, 0, len(gasPrices))
+	for denom := range gasPrices {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	stats := make([]types.GasPrice, len(denoms))
+	for i, denom := range denoms {
+		prices := gasPrices[denom]
+		sort.Slice(prices, func(i, j int) bool {
+			return prices[i].LT(prices[j])
+		})
+
+		stats[i] = types.NewGasPrice(
+			denom,
+			prices[0],
+			percentile(prices, medianPercentile),
+			percentile(prices, p90Percentile),
+			totalFees[denom],
+			int64(len(prices)),
+		)
+	}
+
+	return stats
+}
+
+// percentile returns the given percentile of the provided sorted values,
+// interpolating linearly between the closest ones the same way PostgreSQL percentile_cont does
+func percentile(sorted []sdk.Dec, p sdk.Dec) sdk.Dec {
+	position := p.MulInt64(int64(len(sorted) - 1))
+	lower := position.TruncateInt64()
+	if lower+1 >= int64(len(sorted)) {
+		return sorted[lower]
+	}
+
+	fraction := position.Sub(sdk.NewDec(lower))
+	return sorted[lower].Add(sorted[lower+1].Sub(sorted[lower]).Mul(fraction))
+}
+
+// GetGasUsage returns the total gas used and wanted by the given transactions included inside the block
+// having the provided height
+func GetGasUsage(height int64, txs []*juno.Tx) types.BlockGasUsage {
+	var gasUsed, gasWanted int64
+	for _, tx := range txs {
+		gasUsed += tx.GasUsed
+		gasWanted += tx.GasWanted
+	}
+	return types.NewBlockGasUsage(height, gasUsed, gasWanted)
+}
@@ -0,0 +1,58 @@
+package consensus_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/consensus"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func newTx(fees sdk.Coins, gasWanted, gasUsed int64) *juno.Tx {
+	return &juno.Tx{
+		Tx: &tx.Tx{
+			AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{Amount: fees}},
+		},
+		TxResponse: &sdk.TxResponse{GasWanted: gasWanted, GasUsed: gasUsed},
+	}
+}
+
+func TestGetGasPrices(t *testing.T) {
+	txs := []*juno.Tx{
+		newTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 400)), 100, 80),
+		newTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), 100, 50),
+		newTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 200), sdk.NewInt64Coin("udaric", 50)), 100, 90),
+		newTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 300)), 100, 100),
+
+		// Transactions without gas wanted should be ignored
+		newTx(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), 0, 0),
+	}
+
+	prices := consensus.GetGasPrices(txs)
+	require.Equal(t, []types.GasPrice{
+		types.NewGasPrice(
+			"uatom",
+			sdk.NewDec(1),
+			sdk.NewDecWithPrec(25, 1),
+			sdk.NewDecWithPrec(37, 1),
+			sdkmath.NewInt(1000),
+			4,
+		),
+		types.NewGasPrice(
+			"udaric",
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewDecWithPrec(5, 1),
+			sdkmath.NewInt(50),
+			1,
+		),
+	}, prices)
+
+	usage := consensus.GetGasUsage(10, txs)
+	require.Equal(t, types.NewBlockGasUsage(10, 320, 400), usage)
+}
@@ -0,0 +1,38 @@
+package consensus
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/rs/zerolog/log"
+
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// UpdateParams gets the updated consensus params and stores them inside the database
+func (m *Module) UpdateParams(height int64) error {
+	log.Debug().Str("module", "consensus").Int64("height", height).
+		Msg("updating params")
+
+	params, err := m.source.Params(height)
+	if err != nil {
+		return fmt.Errorf("error while getting params: %s", err)
+	}
+
+	return m.db.SaveConsensusParams(types.NewConsensusParams(params, height))
+}
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      consensustypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&consensustypes.MsgUpdateParams{})},
+
+		// Before x/consensus, the consensus params were stored inside the baseapp legacy subspace
+		Subspaces: []string{baseapp.Paramspace},
+		Refresh:   m.UpdateParams,
+	}
+}
@@ -0,0 +1,68 @@
+package distribution
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the distribution module
+type Config struct {
+	// RewardsSnapshot contains the configuration of the delegators rewards snapshots
+	RewardsSnapshot RewardsSnapshotConfig `yaml:"rewards_snapshot"`
+}
+
+// RewardsSnapshotConfig contains the configuration about the snapshots of the delegators pending rewards
+type RewardsSnapshotConfig struct {
+	// Addresses contains the delegators whose pending rewards should be snapshotted
+	Addresses []string `yaml:"addresses"`
+
+	// Time is the UTC time of the day (in the HH:MM format) at which the snapshots are taken
+	Time string `yaml:"time"`
+}
+
+// TimeOfDay returns the configured snapshot time as the duration elapsed since the beginning of the UTC day
+func (c RewardsSnapshotConfig) TimeOfDay() (time.Duration, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		t, err := time.Parse(layout, c.Time)
+		if err == nil {
+			return t.Sub(t.Truncate(24 * time.Hour)), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid rewards snapshot time %s, it should be in the HH:MM format", c.Time)
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(addresses []string, time string) *Config {
+	return &Config{
+		RewardsSnapshot: RewardsSnapshotConfig{
+			Addresses: addresses,
+			Time:      time,
+		},
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(nil, "00:00")
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"distribution"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+
+	if cfg.Config == nil {
+		return DefaultConfig(), err
+	}
+
+	if cfg.Config.RewardsSnapshot.Time == "" {
+		cfg.Config.RewardsSnapshot.Time = DefaultConfig().RewardsSnapshot.Time
+	}
+
+	return cfg.Config, err
+}
@@ -0,0 +1,40 @@
+package distribution_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/distribution"
+)
+
+func TestRewardsSnapshotConfig_TimeOfDay(t *testing.T) {
+	testCases := []struct {
+		name      string
+		time      string
+		shouldErr bool
+		expected  time.Duration
+	}{
+		{name: "midnight", time: "00:00", expected: 0},
+		{name: "hours and minutes", time: "08:30", expected: 8*time.Hour + 30*time.Minute},
+		{name: "hours, minutes and seconds", time: "23:59:30", expected: 23*time.Hour + 59*time.Minute + 30*time.Second},
+		{name: "invalid hour", time: "25:00", shouldErr: true},
+		{name: "invalid format", time: "8am", shouldErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := distribution.NewConfig(nil, tc.time)
+			timeOfDay, err := cfg.RewardsSnapshot.TimeOfDay()
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, timeOfDay)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package distribution
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, blockResults *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
+) error {
+	err := m.updateCommunityPoolTax(b.Block.Height, blockResults.BeginBlockEvents)
+	if err != nil {
+		log.Error().Str("module", "distribution").Int64("height", b.Block.Height).
+			Err(err).Msg("error while updating community pool tax")
+	}
+
+	return nil
+}
+
+// updateCommunityPoolTax stores the part of the collected fees that has been allocated
+// to the community pool during the BeginBlock of the given height
+func (m *Module) updateCommunityPoolTax(height int64, events []abci.Event) error {
+	tax, err := ParseCommunityPoolTax(events)
+	if err != nil {
+		return err
+	}
+
+	if tax.IsZero() {
+		return nil
+	}
+
+	return m.db.SaveCommunityPoolTax(types.NewCommunityPoolTax(tax, height))
+}
+
+// ParseCommunityPoolTax returns the community tax allocated using the given BeginBlock events.
+// The tax is computed as the difference between the fees moved from the fee collector to the
+// distribution module and the rewards allocated to the validators.
+func ParseCommunityPoolTax(events []abci.Event) (sdk.DecCoins, error) {
+	feeCollectorAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	distrAddress := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+	var feesCollected sdk.DecCoins
+	for _, event := range juno.FindEventsByType(events, banktypes.EventTypeTransfer) {
+		sender, err := juno.FindAttributeByKey(event, sdk.AttributeKeySender)
+		if err != nil || sender.Value != feeCollectorAddress {
+			continue
+		}
+
+		recipient, err := juno.FindAttributeByKey(event, banktypes.AttributeKeyRecipient)
+		if err != nil || recipient.Value != distrAddress {
+			continue
+		}
+
+		amount, err := juno.FindAttributeByKey(event, sdk.AttributeKeyAmount)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting collected fees amount: %s", err)
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing collected fees amount: %s", err)
+		}
+		feesCollected = feesCollected.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	}
+
+	if feesCollected.IsZero() {
+		return nil, nil
+	}
+
+	var rewards sdk.DecCoins
+	for _, event := range juno.FindEventsByType(events, distrtypes.EventTypeRewards) {
+		amount, err := juno.FindAttributeByKey(event, sdk.AttributeKeyAmount)
+		if err != nil || amount.Value == "" {
+			continue
+		}
+
+		coins, err := sdk.ParseDecCoins(amount.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing validator rewards amount: %s", err)
+		}
+		rewards = rewards.Add(coins...)
+	}
+
+	tax, hasNeg := feesCollected.SafeSub(rewards)
+	if hasNeg {
+		return nil, fmt.Errorf("allocated rewards %s are greater than the collected fees %s", rewards, feesCollected)
+	}
+
+	return tax, nil
+}
@@ -29,5 +29,17 @@ func (m *Module) HandleGenesis(doc *tmtypes.GenesisDoc, appState map[string]json
 		return fmt.Errorf("error while storing genesis distribution params: %s", err)
 	}
 
+	// Save the withdraw addresses
+	var withdrawAddresses []types.DelegatorWithdrawAddress
+	for _, info := range genState.DelegatorWithdrawInfos {
+		withdrawAddresses = append(withdrawAddresses,
+			types.NewDelegatorWithdrawAddress(info.DelegatorAddress, info.WithdrawAddress, doc.InitialHeight))
+	}
+
+	err = m.db.SaveDelegatorWithdrawAddresses(withdrawAddresses)
+	if err != nil {
+		return fmt.Errorf("error while storing genesis delegators withdraw addresses: %s", err)
+	}
+
 	return nil
 }
@@ -1,11 +1,15 @@
 package distribution
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	juno "github.com/forbole/juno/v5/types"
 
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 // HandleMsgExec implements modules.AuthzMessageModule
@@ -14,13 +18,82 @@ func (m *Module) HandleMsgExec(index int, _ *authz.MsgExec, _ int, executedMsg s
 }
 
 // HandleMsg implements modules.MessageModule
-func (m *Module) HandleMsg(_ int, msg sdk.Msg, tx *juno.Tx) error {
+func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
 	if len(tx.Logs) == 0 {
 		return nil
 	}
 
-	if _, ok := msg.(*distrtypes.MsgFundCommunityPool); ok {
-		return m.updateCommunityPool(tx.Height)
+	// Rewards are withdrawn by MsgWithdrawDelegatorReward as well as by every
+	// message that changes the shares of a delegation, so we always look for them
+	err := m.handleRewardsWithdrawals(index, msg, tx)
+	if err != nil {
+		return err
 	}
+
+	switch cosmosMsg := msg.(type) {
+	case *distrtypes.MsgWithdrawValidatorCommission:
+		return m.handleMsgWithdrawValidatorCommission(index, cosmosMsg, tx)
+
+	case *distrtypes.MsgSetWithdrawAddress:
+		return m.handleMsgSetWithdrawAddress(cosmosMsg, tx)
+
+	case *distrtypes.MsgFundCommunityPool:
+		return m.handleMsgFundCommunityPool(index, cosmosMsg, tx)
+	}
+
 	return nil
 }
+
+// handleRewardsWithdrawals stores all the rewards withdrawals emitted by the message having the given index
+func (m *Module) handleRewardsWithdrawals(index int, msg sdk.Msg, tx *juno.Tx) error {
+	withdrawals, err := ParseRewardsWithdrawals(index, msg, tx)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveDelegatorRewardWithdrawals(withdrawals)
+}
+
+// handleMsgWithdrawValidatorCommission stores the commission withdrawn by the given message
+func (m *Module) handleMsgWithdrawValidatorCommission(
+	index int, msg *distrtypes.MsgWithdrawValidatorCommission, tx *juno.Tx,
+) error {
+	event, err := tx.FindEventByType(index, distrtypes.EventTypeWithdrawCommission)
+	if err != nil {
+		// No commission has been withdrawn
+		return nil
+	}
+
+	amountStr, err := tx.FindAttributeByKey(event, sdk.AttributeKeyAmount)
+	if err != nil || amountStr == "" {
+		return nil
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(amountStr)
+	if err != nil {
+		return fmt.Errorf("error while parsing withdrawn commission amount: %s", err)
+	}
+
+	return m.db.SaveValidatorCommissionWithdrawals([]types.ValidatorCommissionWithdrawal{
+		types.NewValidatorCommissionWithdrawal(msg.ValidatorAddress, amount, tx.TxHash, index, tx.Height),
+	})
+}
+
+// handleMsgSetWithdrawAddress stores the new withdraw address of the delegator
+func (m *Module) handleMsgSetWithdrawAddress(msg *distrtypes.MsgSetWithdrawAddress, tx *juno.Tx) error {
+	return m.db.SaveDelegatorWithdrawAddresses([]types.DelegatorWithdrawAddress{
+		types.NewDelegatorWithdrawAddress(msg.DelegatorAddress, msg.WithdrawAddress, tx.Height),
+	})
+}
+
+// handleMsgFundCommunityPool stores the deposit made to the community pool and refreshes its balance
+func (m *Module) handleMsgFundCommunityPool(index int, msg *distrtypes.MsgFundCommunityPool, tx *juno.Tx) error {
+	err := m.db.SaveCommunityPoolDeposit(
+		types.NewCommunityPoolDeposit(msg.Depositor, msg.Amount, tx.TxHash, index, tx.Height),
+	)
+	if err != nil {
+		return err
+	}
+
+	return m.updateCommunityPool(tx.Height)
+}
@@ -20,6 +20,22 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return fmt.Errorf("error while scheduling distribution peridic operation: %s", err)
 	}
 
+	// Update the validators outstanding rewards and commission every 1 hour
+	if _, err := scheduler.Every(1).Hour().Do(func() {
+		utils.WatchMethod(m.UpdateValidatorsRewards)
+	}); err != nil {
+		return fmt.Errorf("error while scheduling distribution peridic operation: %s", err)
+	}
+
+	// Snapshot the rewards of the watched delegators every day
+	if len(m.cfg.RewardsSnapshot.Addresses) > 0 {
+		if _, err := scheduler.Every(1).Day().At(m.cfg.RewardsSnapshot.Time).Do(func() {
+			utils.WatchMethod(m.SnapshotWatchedDelegatorsRewards)
+		}); err != nil {
+			return fmt.Errorf("error while scheduling distribution peridic operation: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -2,31 +2,48 @@ package distribution
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/forbole/juno/v5/types/config"
 
 	distrsource "github.com/forbole/bdjuno/v4/modules/distribution/source"
 
 	"github.com/forbole/juno/v5/modules"
 
 	"github.com/forbole/bdjuno/v4/database"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
 var (
 	_ modules.Module                   = &Module{}
 	_ modules.GenesisModule            = &Module{}
+	_ modules.BlockModule              = &Module{}
 	_ modules.PeriodicOperationsModule = &Module{}
 	_ modules.MessageModule            = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represents the x/distr module
 type Module struct {
+	cfg    *Config
 	cdc    codec.Codec
 	db     *database.Db
 	source distrsource.Source
 }
 
 // NewModule returns a new Module instance
-func NewModule(source distrsource.Source, cdc codec.Codec, db *database.Db) *Module {
+func NewModule(cfg config.Config, source distrsource.Source, cdc codec.Codec, db *database.Db) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	distrCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
 	return &Module{
+		cfg:    distrCfg,
 		cdc:    cdc,
 		db:     db,
 		source: source,
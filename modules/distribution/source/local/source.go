@@ -45,6 +45,24 @@ func (s Source) ValidatorCommission(valOperAddr string, height int64) (sdk.DecCo
 	return res.Commission.Commission, nil
 }
 
+// ValidatorOutstandingRewards implements distrsource.Source
+func (s Source) ValidatorOutstandingRewards(valOperAddr string, height int64) (sdk.DecCoins, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.q.ValidatorOutstandingRewards(
+		sdk.WrapSDKContext(ctx),
+		&distrtypes.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: valOperAddr},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Rewards.Rewards, nil
+}
+
 // DelegatorTotalRewards implements distrsource.Source
 func (s Source) DelegatorTotalRewards(delegator string, height int64) ([]distrtypes.DelegationDelegatorReward, error) {
 	ctx, err := s.LoadHeight(height)
@@ -49,3 +49,16 @@ func (s Source) ValidatorCommission(valOperAddr string, height int64) (sdk.DecCo
 
 	return res.Commission.Commission, nil
 }
+
+// ValidatorOutstandingRewards implements distrsource.Source
+func (s Source) ValidatorOutstandingRewards(valOperAddr string, height int64) (sdk.DecCoins, error) {
+	res, err := s.distrClient.ValidatorOutstandingRewards(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&distrtypes.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: valOperAddr},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Rewards.Rewards, nil
+}
@@ -7,6 +7,7 @@ import (
 
 type Source interface {
 	ValidatorCommission(valOperAddr string, height int64) (sdk.DecCoins, error)
+	ValidatorOutstandingRewards(valOperAddr string, height int64) (sdk.DecCoins, error)
 	DelegatorTotalRewards(delegator string, height int64) ([]distrtypes.DelegationDelegatorReward, error)
 	DelegatorWithdrawAddress(delegator string, height int64) (string, error)
 	CommunityPool(height int64) (sdk.DecCoins, error)
@@ -3,7 +3,10 @@ package distribution
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
 // updateCommunityPool fetch total amount of coins in the system from RPC and store it into database
@@ -18,3 +21,19 @@ func (m *Module) updateCommunityPool(height int64) error {
 	// Store the pool into the database
 	return m.db.SaveCommunityPool(pool, height)
 }
+
+// HandleCommunityPoolSpend stores the given amount spent from the community pool by the proposal
+// having the given id, and refreshes the community pool balance
+func (m *Module) HandleCommunityPoolSpend(
+	height int64, proposalID uint64, msgIndex int, recipient string, amount sdk.Coins,
+) error {
+	log.Debug().Str("module", "distribution").Int64("height", height).
+		Uint64("proposal", proposalID).Msg("handling community pool spend")
+
+	err := m.db.SaveCommunityPoolSpend(types.NewCommunityPoolSpend(proposalID, msgIndex, recipient, amount, height))
+	if err != nil {
+		return err
+	}
+
+	return m.updateCommunityPool(height)
+}
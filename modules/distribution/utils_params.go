@@ -3,8 +3,11 @@ package distribution
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/rs/zerolog/log"
 
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -21,3 +24,13 @@ func (m *Module) UpdateParams(height int64) error {
 	return m.db.SaveDistributionParams(types.NewDistributionParams(params, height))
 
 }
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      distrtypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&distrtypes.MsgUpdateParams{})},
+		Subspaces:   []string{distrtypes.ModuleName},
+		Refresh:     m.UpdateParams,
+	}
+}
@@ -0,0 +1,59 @@
+package distribution
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// WatchedDelegators returns the delegators whose pending rewards are snapshotted periodically
+func (m *Module) WatchedDelegators() []string {
+	return m.cfg.RewardsSnapshot.Addresses
+}
+
+// RewardsSnapshotTimeOfDay returns the UTC time of the day at which the rewards snapshots are taken,
+// as the duration elapsed since the beginning of the day
+func (m *Module) RewardsSnapshotTimeOfDay() (time.Duration, error) {
+	return m.cfg.RewardsSnapshot.TimeOfDay()
+}
+
+// SnapshotWatchedDelegatorsRewards stores the pending rewards of all the delegators
+// configured inside the watch list, using the latest block stored inside the database
+func (m *Module) SnapshotWatchedDelegatorsRewards() error {
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	for _, delegator := range m.cfg.RewardsSnapshot.Addresses {
+		err = m.SnapshotDelegatorRewards(delegator, block.Height, block.BlockTimestamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotDelegatorRewards stores the pending rewards of the given delegator at the given height
+func (m *Module) SnapshotDelegatorRewards(delegator string, height int64, timestamp time.Time) error {
+	log.Debug().Str("module", "distribution").Str("delegator", delegator).
+		Int64("height", height).Msg("snapshotting delegator rewards")
+
+	rewards, err := m.source.DelegatorTotalRewards(delegator, height)
+	if err != nil {
+		return fmt.Errorf("error while getting delegator rewards: %s", err)
+	}
+
+	snapshots := make([]types.DelegatorRewardSnapshot, len(rewards))
+	for i, reward := range rewards {
+		snapshots[i] = types.NewDelegatorRewardSnapshot(
+			delegator, reward.ValidatorAddress, reward.Reward, height, timestamp,
+		)
+	}
+
+	return m.db.SaveDelegatorRewardSnapshots(snapshots)
+}
@@ -0,0 +1,45 @@
+package distribution
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// UpdateValidatorsRewards stores the outstanding rewards and the accumulated commission
+// of all the validators, using the latest block stored inside the database
+func (m *Module) UpdateValidatorsRewards() error {
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	log.Debug().Str("module", "distribution").Int64("height", block.Height).
+		Msg("updating validators rewards")
+
+	validators, err := m.db.GetValidators()
+	if err != nil {
+		return fmt.Errorf("error while getting validators: %s", err)
+	}
+
+	var rewards []types.ValidatorRewards
+	for _, validator := range validators {
+		outstanding, err := m.source.ValidatorOutstandingRewards(validator.GetOperator(), block.Height)
+		if err != nil {
+			return fmt.Errorf("error while getting validator outstanding rewards: %s", err)
+		}
+
+		commission, err := m.source.ValidatorCommission(validator.GetOperator(), block.Height)
+		if err != nil {
+			return fmt.Errorf("error while getting validator commission: %s", err)
+		}
+
+		rewards = append(rewards, types.NewValidatorRewards(
+			validator.GetConsAddr(), outstanding, commission, block.Height, block.BlockTimestamp,
+		))
+	}
+
+	return m.db.SaveValidatorsRewards(rewards)
+}
@@ -0,0 +1,89 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// ParseRewardsWithdrawals returns all the rewards withdrawals contained inside the
+// withdraw_rewards events emitted by the message having the given index.
+// Since events of the same type are merged together inside the logs, a new withdrawal
+// starts every time an amount attribute is found.
+func ParseRewardsWithdrawals(index int, msg sdk.Msg, tx *juno.Tx) ([]types.DelegatorRewardWithdrawal, error) {
+	event, err := tx.FindEventByType(index, distrtypes.EventTypeWithdrawRewards)
+	if err != nil {
+		// No rewards have been withdrawn
+		return nil, nil
+	}
+
+	var withdrawals []types.DelegatorRewardWithdrawal
+	var current *types.DelegatorRewardWithdrawal
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case sdk.AttributeKeyAmount:
+			if current != nil {
+				withdrawals = append(withdrawals, *current)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				return nil, fmt.Errorf("error while parsing withdrawn rewards amount: %s", err)
+			}
+
+			withdrawal := types.NewDelegatorRewardWithdrawal(
+				getMsgDelegator(msg), "", amount, tx.TxHash, index, tx.Height,
+			)
+			current = &withdrawal
+
+		case distrtypes.AttributeKeyValidator:
+			if current != nil {
+				current.ValidatorAddress = attr.Value
+			}
+
+		case distrtypes.AttributeKeyDelegator:
+			if current != nil {
+				current.DelegatorAddress = attr.Value
+			}
+		}
+	}
+
+	if current != nil {
+		withdrawals = append(withdrawals, *current)
+	}
+
+	// Remove the withdrawals that did not transfer any amount or that cannot be attributed
+	var filtered []types.DelegatorRewardWithdrawal
+	for _, withdrawal := range withdrawals {
+		if withdrawal.Amount.IsZero() || withdrawal.DelegatorAddress == "" || withdrawal.ValidatorAddress == "" {
+			continue
+		}
+		filtered = append(filtered, withdrawal)
+	}
+
+	return filtered, nil
+}
+
+// getMsgDelegator returns the delegator of the given message, if any.
+// This is used for events emitted by older chain versions that do not include the delegator attribute.
+func getMsgDelegator(msg sdk.Msg) string {
+	switch cosmosMsg := msg.(type) {
+	case *distrtypes.MsgWithdrawDelegatorReward:
+		return cosmosMsg.DelegatorAddress
+	case *stakingtypes.MsgDelegate:
+		return cosmosMsg.DelegatorAddress
+	case *stakingtypes.MsgUndelegate:
+		return cosmosMsg.DelegatorAddress
+	case *stakingtypes.MsgBeginRedelegate:
+		return cosmosMsg.DelegatorAddress
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		return cosmosMsg.DelegatorAddress
+	default:
+		return ""
+	}
+}
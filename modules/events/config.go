@@ -0,0 +1,62 @@
+package events
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains the configuration about the events module
+type Config struct {
+	// Include contains the types of the events to be indexed. When empty, all the events are indexed
+	Include []string `yaml:"include"`
+
+	// Exclude contains the types of the events that should never be indexed
+	Exclude []string `yaml:"exclude"`
+}
+
+// NewConfig returns a new Config instance
+func NewConfig(include, exclude []string) *Config {
+	return &Config{
+		Include: include,
+		Exclude: exclude,
+	}
+}
+
+// DefaultConfig returns the default configuration
+func DefaultConfig() *Config {
+	return NewConfig(nil, nil)
+}
+
+func ParseConfig(bz []byte) (*Config, error) {
+	type T struct {
+		Config *Config `yaml:"events"`
+	}
+	var cfg T
+	err := yaml.Unmarshal(bz, &cfg)
+
+	if cfg.Config == nil {
+		return DefaultConfig(), err
+	}
+
+	return cfg.Config, err
+}
+
+// ShouldIndex tells whether the events having the given type should be indexed
+func (c *Config) ShouldIndex(eventType string) bool {
+	for _, excluded := range c.Exclude {
+		if excluded == eventType {
+			return false
+		}
+	}
+
+	if len(c.Include) == 0 {
+		return true
+	}
+
+	for _, included := range c.Include {
+		if included == eventType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package events
+
+import (
+	"fmt"
+
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, r *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
+) error {
+	log.Trace().Str("module", "events").Int64("height", b.Block.Height).
+		Msg("indexing block events")
+
+	err := m.db.SaveBlockEvents(b.Block.Height, types.EventSourceBeginBlock,
+		m.filterEvents(ConvertABCIEvents(b.Block.Height, types.EventSourceBeginBlock, "", r.BeginBlockEvents)))
+	if err != nil {
+		return fmt.Errorf("error while storing begin block events: %s", err)
+	}
+
+	err = m.db.SaveBlockEvents(b.Block.Height, types.EventSourceEndBlock,
+		m.filterEvents(ConvertABCIEvents(b.Block.Height, types.EventSourceEndBlock, "", r.EndBlockEvents)))
+	if err != nil {
+		return fmt.Errorf("error while storing end block events: %s", err)
+	}
+
+	return nil
+}
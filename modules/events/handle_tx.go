@@ -0,0 +1,19 @@
+package events
+
+import (
+	"fmt"
+
+	juno "github.com/forbole/juno/v5/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	events := ConvertTxEvents(tx.Height, tx.TxHash, tx.Events, tx.Logs)
+
+	err := m.db.SaveTxEvents(tx.TxHash, m.filterEvents(events))
+	if err != nil {
+		return fmt.Errorf("error while storing transaction events: %s", err)
+	}
+
+	return nil
+}
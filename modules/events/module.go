@@ -0,0 +1,43 @@
+package events
+
+import (
+	"github.com/forbole/juno/v5/modules"
+	"github.com/forbole/juno/v5/types/config"
+
+	"github.com/forbole/bdjuno/v4/database"
+)
+
+var (
+	_ modules.Module            = &Module{}
+	_ modules.BlockModule       = &Module{}
+	_ modules.TransactionModule = &Module{}
+)
+
+// Module represents the module that indexes the transactions and Begin/EndBlock events
+type Module struct {
+	cfg *Config
+	db  *database.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(cfg config.Config, db *database.Db) *Module {
+	bz, err := cfg.GetBytes()
+	if err != nil {
+		panic(err)
+	}
+
+	eventsCfg, err := ParseConfig(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		cfg: eventsCfg,
+		db:  db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "events"
+}
@@ -0,0 +1,103 @@
+package events
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// ConvertABCIEvents converts the given ABCI events emitted from the provided source into Event instances
+func ConvertABCIEvents(height int64, source string, txHash string, abciEvents []abci.Event) []types.Event {
+	events := make([]types.Event, len(abciEvents))
+	for index, event := range abciEvents {
+		attributes := make([]types.EventAttribute, len(event.Attributes))
+		for i, attribute := range event.Attributes {
+			attributes[i] = types.NewEventAttribute(attribute.Key, attribute.Value)
+		}
+
+		events[index] = types.NewEvent(height, source, txHash, nil, index, event.Type, attributes)
+	}
+	return events
+}
+
+// ConvertTxEvents converts the events emitted by a transaction into Event instances.
+// The events emitted by each message are associated to its index using the transaction logs,
+// while the other ones (eg. the fees payment, or all the events of a failed transaction) have no message index
+func ConvertTxEvents(height int64, txHash string, abciEvents []abci.Event, logs sdk.ABCIMessageLogs) []types.Event {
+	events := ConvertABCIEvents(height, types.EventSourceTx, txHash, abciEvents)
+
+	position := 0
+	for _, log := range logs {
+		// The events of each message are emitted one after the other,
+		// starting with the "message" event containing the message action
+		start := findMessageEvent(abciEvents[position:], getLogAction(log))
+		if start < 0 {
+			continue
+		}
+		position += start
+
+		// The logs merge the events having the same type,
+		// so we rely on the number of attributes to know where the message events end
+		msgIndex := log.MsgIndex
+		remaining := countLogAttributes(log)
+		for ; position < len(events) && remaining > 0; position++ {
+			events[position].MsgIndex = &msgIndex
+			remaining -= len(abciEvents[position].Attributes)
+		}
+	}
+
+	return events
+}
+
+// getLogAction returns the action of the message the given log refers to, or an empty string if not found
+func getLogAction(log sdk.ABCIMessageLog) string {
+	for _, event := range log.Events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+
+		for _, attribute := range event.Attributes {
+			if attribute.Key == sdk.AttributeKeyAction {
+				return attribute.Value
+			}
+		}
+	}
+	return ""
+}
+
+// findMessageEvent returns the position of the first "message" event having the given action, or -1 if not found
+func findMessageEvent(events []abci.Event, action string) int {
+	for index, event := range events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+
+		for _, attribute := range event.Attributes {
+			if attribute.Key == sdk.AttributeKeyAction && attribute.Value == action {
+				return index
+			}
+		}
+	}
+	return -1
+}
+
+// countLogAttributes returns the number of attributes of all the events contained inside the given log
+func countLogAttributes(log sdk.ABCIMessageLog) int {
+	count := 0
+	for _, event := range log.Events {
+		count += len(event.Attributes)
+	}
+	return count
+}
+
+// filterEvents returns the events that should be indexed based on the module configuration
+func (m *Module) filterEvents(events []types.Event) []types.Event {
+	var filtered []types.Event
+	for _, event := range events {
+		if m.cfg.ShouldIndex(event.Type) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
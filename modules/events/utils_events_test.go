@@ -0,0 +1,77 @@
+package events_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/events"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestConvertTxEvents(t *testing.T) {
+	abciEvents := []abci.Event{
+		{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee", Value: "10uatom"}}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "/cosmos.bank.v1beta1.MsgSend"}}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10uatom"}}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "sender", Value: "cosmos1"}}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "/cosmos.bank.v1beta1.MsgSend"}}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "20uatom"}}},
+	}
+
+	// Logs merge the events having the same type
+	logs := sdk.ABCIMessageLogs{
+		{MsgIndex: 0, Events: sdk.StringEvents{
+			{Type: "message", Attributes: []sdk.Attribute{
+				{Key: "action", Value: "/cosmos.bank.v1beta1.MsgSend"}, {Key: "sender", Value: "cosmos1"},
+			}},
+			{Type: "transfer", Attributes: []sdk.Attribute{{Key: "amount", Value: "10uatom"}}},
+		}},
+		{MsgIndex: 1, Events: sdk.StringEvents{
+			{Type: "message", Attributes: []sdk.Attribute{{Key: "action", Value: "/cosmos.bank.v1beta1.MsgSend"}}},
+			{Type: "transfer", Attributes: []sdk.Attribute{{Key: "amount", Value: "20uatom"}}},
+		}},
+	}
+
+	converted := events.ConvertTxEvents(10, "hash", abciEvents, logs)
+	require.Len(t, converted, len(abciEvents))
+
+	var msgIndexes []*uint32
+	for index, event := range converted {
+		require.Equal(t, index, event.Index)
+		require.Equal(t, "hash", event.TxHash)
+		msgIndexes = append(msgIndexes, event.MsgIndex)
+	}
+
+	first, second := uint32(0), uint32(1)
+	require.Equal(t, []*uint32{nil, &first, &first, &first, &second, &second}, msgIndexes)
+
+	// Failed transactions have no logs, so none of their events is associated to a message
+	for _, event := range events.ConvertTxEvents(10, "hash", abciEvents[:1], nil) {
+		require.Nil(t, event.MsgIndex)
+	}
+}
+
+func TestConvertABCIEvents(t *testing.T) {
+	abciEvents := []abci.Event{
+		{Type: "mint", Attributes: []abci.EventAttribute{{Key: "amount", Value: "100"}}},
+	}
+
+	require.Equal(t, []types.Event{
+		types.NewEvent(10, types.EventSourceBeginBlock, "", nil, 0, "mint",
+			[]types.EventAttribute{types.NewEventAttribute("amount", "100")}),
+	}, events.ConvertABCIEvents(10, types.EventSourceBeginBlock, "", abciEvents))
+}
+
+func TestConfig_ShouldIndex(t *testing.T) {
+	cfg := events.NewConfig(nil, []string{"coin_spent", "coin_received"})
+	require.True(t, cfg.ShouldIndex("transfer"))
+	require.False(t, cfg.ShouldIndex("coin_spent"))
+
+	cfg = events.NewConfig([]string{"transfer", "coin_spent"}, []string{"coin_spent"})
+	require.True(t, cfg.ShouldIndex("transfer"))
+	require.False(t, cfg.ShouldIndex("coin_spent"))
+	require.False(t, cfg.ShouldIndex("message"))
+}
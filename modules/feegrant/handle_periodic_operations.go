@@ -0,0 +1,45 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/utils"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "feegrant").Msg("setting up periodic tasks")
+
+	// Mark the expired allowances every 5 minutes
+	if _, err := scheduler.Every(5).Minutes().Do(func() {
+		utils.WatchMethod(m.SweepExpiredAllowances)
+	}); err != nil {
+		return fmt.Errorf("error while setting up feegrant periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// SweepExpiredAllowances marks as expired all the allowances having an expiration not after the latest block time.
+// The chain prunes the expired allowances without emitting any event, so they need to be detected this way
+func (m *Module) SweepExpiredAllowances() error {
+	log.Trace().Str("module", "feegrant").Str("operation", "expired allowances").
+		Msg("marking expired fee grant allowances")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return fmt.Errorf("error while getting latest block height: %s", err)
+	}
+
+	count, err := m.db.SetFeeGrantAllowancesExpired(block.BlockTimestamp)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().Str("module", "feegrant").Int64("height", block.Height).Int64("count", count).
+		Msg("marked expired fee grant allowances")
+	return nil
+}
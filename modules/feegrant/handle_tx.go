@@ -0,0 +1,119 @@
+package feegrant
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	// The fee grant events are emitted by the ante handler, so they are not part of the messages logs
+	for _, event := range juno.FindEventsByType(tx.Events, feegranttypes.EventTypeUseFeeGrant) {
+		granter, grantee, err := getGrantParties(event)
+		if err != nil {
+			return err
+		}
+
+		var fee sdk.Coins
+		if tx.AuthInfo != nil && tx.AuthInfo.Fee != nil {
+			fee = tx.AuthInfo.Fee.Amount
+		}
+
+		err = m.db.SaveFeeGrantUsage(types.NewFeeGrantUsage(tx.TxHash, granter, grantee, fee, tx.Height))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Allowances that have been used up or expired are revoked while deducting the fees
+	revoked := map[string]bool{}
+	for _, event := range juno.FindEventsByType(tx.Events, feegranttypes.EventTypeRevokeFeeGrant) {
+		granter, grantee, err := getGrantParties(event)
+		if err != nil {
+			return err
+		}
+
+		revoked[granter+grantee] = true
+		err = m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(grantee, granter, tx.Height))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Refresh the allowances that have been updated by spending them
+	for _, event := range juno.FindEventsByType(tx.Events, feegranttypes.EventTypeUpdateFeeGrant) {
+		granter, grantee, err := getGrantParties(event)
+		if err != nil {
+			return err
+		}
+
+		if revoked[granter+grantee] {
+			continue
+		}
+
+		err = m.RefreshAllowance(granter, grantee, tx.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getGrantParties returns the granter and grantee addresses contained inside the given fee grant event
+func getGrantParties(event abci.Event) (granter string, grantee string, err error) {
+	granterAttr, err := juno.FindAttributeByKey(event, feegranttypes.AttributeKeyGranter)
+	if err != nil {
+		return "", "", fmt.Errorf("error while getting fee grant granter address: %s", err)
+	}
+
+	granteeAttr, err := juno.FindAttributeByKey(event, feegranttypes.AttributeKeyGrantee)
+	if err != nil {
+		return "", "", fmt.Errorf("error while getting fee grant grantee address: %s", err)
+	}
+
+	return granterAttr.Value, granteeAttr.Value, nil
+}
+
+// RefreshAllowance gets the allowance given by the granter to the grantee at the given height
+// and stores it inside the database, so that the remaining spend limit is up to date
+func (m *Module) RefreshAllowance(granter string, grantee string, height int64) error {
+	grant, err := m.source.Allowance(granter, grantee, height)
+	if err != nil {
+		return fmt.Errorf("error while getting fee grant allowance: %s", err)
+	}
+
+	feeGrant, err := m.unpackGrant(grant)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, height))
+}
+
+// unpackGrant rebuilds the given grant unpacking its allowance, so that it can be serialized
+func (m *Module) unpackGrant(grant *feegranttypes.Grant) (feegranttypes.Grant, error) {
+	var allowance feegranttypes.FeeAllowanceI
+	err := m.cdc.UnpackAny(grant.Allowance, &allowance)
+	if err != nil {
+		return feegranttypes.Grant{}, fmt.Errorf("error while unpacking fee grant allowance: %s", err)
+	}
+
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return feegranttypes.Grant{}, fmt.Errorf("error while parsing granter address: %s", err)
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return feegranttypes.Grant{}, fmt.Errorf("error while parsing grantee address: %s", err)
+	}
+
+	return feegranttypes.NewGrant(granter, grantee, allowance)
+}
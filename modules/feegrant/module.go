@@ -4,27 +4,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/forbole/bdjuno/v4/database"
+	feegrantsource "github.com/forbole/bdjuno/v4/modules/feegrant/source"
 
 	"github.com/forbole/juno/v5/modules"
 )
 
 var (
-	_ modules.BlockModule   = &Module{}
-	_ modules.Module        = &Module{}
-	_ modules.MessageModule = &Module{}
+	_ modules.Module                   = &Module{}
+	_ modules.MessageModule            = &Module{}
+	_ modules.TransactionModule        = &Module{}
+	_ modules.PeriodicOperationsModule = &Module{}
 )
 
 // Module represent x/feegrant module
 type Module struct {
-	cdc codec.Codec
-	db  *database.Db
+	cdc    codec.Codec
+	db     *database.Db
+	source feegrantsource.Source
 }
 
 // NewModule returns a new Module instance
-func NewModule(cdc codec.Codec, db *database.Db) *Module {
+func NewModule(source feegrantsource.Source, cdc codec.Codec, db *database.Db) *Module {
 	return &Module{
-		cdc: cdc,
-		db:  db,
+		cdc:    cdc,
+		db:     db,
+		source: source,
 	}
 }
 
@@ -0,0 +1,81 @@
+package local
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/forbole/juno/v5/node/local"
+
+	feegrantsource "github.com/forbole/bdjuno/v4/modules/feegrant/source"
+)
+
+var (
+	_ feegrantsource.Source = &Source{}
+)
+
+// Source implements feegrantsource.Source using a local node
+type Source struct {
+	*local.Source
+	querier feegranttypes.QueryServer
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *local.Source, querier feegranttypes.QueryServer) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Allowance implements feegrantsource.Source
+func (s Source) Allowance(granter string, grantee string, height int64) (*feegranttypes.Grant, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.Allowance(
+		sdk.WrapSDKContext(ctx),
+		&feegranttypes.QueryAllowanceRequest{Granter: granter, Grantee: grantee},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Allowance, nil
+}
+
+// AllowancesByGranter implements feegrantsource.Source
+func (s Source) AllowancesByGranter(granter string, height int64) ([]*feegranttypes.Grant, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	var grants []*feegranttypes.Grant
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.querier.AllowancesByGranter(
+			sdk.WrapSDKContext(ctx),
+			&feegranttypes.QueryAllowancesByGranterRequest{
+				Granter: granter,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 allowances at a time
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		grants = append(grants, res.Allowances...)
+	}
+
+	return grants, nil
+}
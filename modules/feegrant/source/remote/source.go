@@ -0,0 +1,70 @@
+package remote
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/forbole/juno/v5/node/remote"
+
+	feegrantsource "github.com/forbole/bdjuno/v4/modules/feegrant/source"
+)
+
+var (
+	_ feegrantsource.Source = &Source{}
+)
+
+// Source implements feegrantsource.Source using a remote node
+type Source struct {
+	*remote.Source
+	querier feegranttypes.QueryClient
+}
+
+// NewSource returns a new Source instance
+func NewSource(source *remote.Source, querier feegranttypes.QueryClient) *Source {
+	return &Source{
+		Source:  source,
+		querier: querier,
+	}
+}
+
+// Allowance implements feegrantsource.Source
+func (s Source) Allowance(granter string, grantee string, height int64) (*feegranttypes.Grant, error) {
+	res, err := s.querier.Allowance(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&feegranttypes.QueryAllowanceRequest{Granter: granter, Grantee: grantee},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Allowance, nil
+}
+
+// AllowancesByGranter implements feegrantsource.Source
+func (s Source) AllowancesByGranter(granter string, height int64) ([]*feegranttypes.Grant, error) {
+	ctx := remote.GetHeightRequestContext(s.Ctx, height)
+
+	var grants []*feegranttypes.Grant
+	var nextKey []byte
+	var stop = false
+	for !stop {
+		res, err := s.querier.AllowancesByGranter(
+			ctx,
+			&feegranttypes.QueryAllowancesByGranterRequest{
+				Granter: granter,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: 100, // Query 100 allowances at a time
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextKey = res.Pagination.NextKey
+		stop = len(res.Pagination.NextKey) == 0
+		grants = append(grants, res.Allowances...)
+	}
+
+	return grants, nil
+}
@@ -0,0 +1,10 @@
+package source
+
+import (
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+type Source interface {
+	Allowance(granter string, grantee string, height int64) (*feegranttypes.Grant, error)
+	AllowancesByGranter(granter string, height int64) ([]*feegranttypes.Grant, error)
+}
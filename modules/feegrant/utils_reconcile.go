@@ -0,0 +1,114 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/rs/zerolog/log"
+
+	dbtypes "github.com/forbole/bdjuno/v4/database/types"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// ReconcileGranterAllowances compares the allowances stored for the given granter against the ones
+// that exist on chain at the given height. Allowances that are missing or outdated are stored again,
+// while the ones that no longer exist on chain are removed unless they have been marked as expired.
+// Allowances expiring at or before the given block time are kept marked as expired, even if the chain has not pruned them yet.
+// The expired allowances should be swept before calling this method. It returns the number of allowances that have been fixed
+func (m *Module) ReconcileGranterAllowances(granter string, height int64, blockTime time.Time) (int, error) {
+	log.Debug().Str("module", "feegrant").Str("granter", granter).Int64("height", height).
+		Msg("reconciling fee grant allowances")
+
+	grants, err := m.source.AllowancesByGranter(granter, height)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting fee grant allowances: %s", err)
+	}
+
+	rows, err := m.db.GetFeeGrantAllowancesByGranter(granter)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting stored fee grant allowances: %s", err)
+	}
+
+	stored := make(map[string]dbtypes.FeeAllowanceRow, len(rows))
+	for _, row := range rows {
+		stored[row.Grantee] = row
+	}
+
+	fixed := 0
+	foundExpired := false
+	onChain := make(map[string]bool, len(grants))
+	for _, grant := range grants {
+		onChain[grant.Grantee] = true
+
+		row, isStored := stored[grant.Grantee]
+		if isStored && row.Expiration.Valid && !row.Expiration.Time.After(blockTime) {
+			// The allowance has expired but has not been pruned yet, so storing it again would un-expire it
+			continue
+		}
+
+		feeGrant, err := m.unpackGrant(grant)
+		if err != nil {
+			return fixed, err
+		}
+
+		if !isStored {
+			log.Info().Str("module", "feegrant").Str("granter", granter).Str("grantee", grant.Grantee).
+				Msg("found fee grant allowance missing from the database")
+			fixed++
+
+			expired, err := isGrantExpired(feeGrant, blockTime)
+			if err != nil {
+				return fixed, err
+			}
+			foundExpired = foundExpired || expired
+		}
+
+		// Store the allowance anyway so that its remaining spend limit is up to date
+		err = m.db.SaveFeeGrantAllowance(types.NewFeeGrant(feeGrant, height))
+		if err != nil {
+			return fixed, err
+		}
+	}
+
+	// Missing allowances are stored as not expired, so they need to be marked if they have expired already
+	if foundExpired {
+		_, err = m.db.SetFeeGrantAllowancesExpired(blockTime)
+		if err != nil {
+			return fixed, err
+		}
+	}
+
+	for _, row := range rows {
+		// Expired allowances are pruned by the chain, but we keep them marked as expired
+		if onChain[row.Grantee] || row.Expired {
+			continue
+		}
+
+		log.Info().Str("module", "feegrant").Str("granter", granter).Str("grantee", row.Grantee).
+			Msg("found fee grant allowance no longer existing on chain")
+		fixed++
+
+		err = m.db.DeleteFeeGrantAllowance(types.NewGrantRemoval(row.Grantee, granter, height))
+		if err != nil {
+			return fixed, err
+		}
+	}
+
+	return fixed, nil
+}
+
+// isGrantExpired tells whether the given grant expires at or before the provided block time
+func isGrantExpired(grant feegranttypes.Grant, blockTime time.Time) (bool, error) {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return false, fmt.Errorf("error while getting grant allowance: %s", err)
+	}
+
+	expiration, err := allowance.ExpiresAt()
+	if err != nil {
+		return false, fmt.Errorf("error while getting grant allowance expiration: %s", err)
+	}
+
+	return expiration != nil && !expiration.After(blockTime), nil
+}
@@ -1,23 +1,15 @@
 package gov
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/forbole/bdjuno/v4/types"
 )
 
 type DistrModule interface {
-	UpdateParams(height int64) error
-}
-
-type MintModule interface {
-	UpdateParams(height int64) error
-	UpdateInflation() error
-}
-
-type SlashingModule interface {
-	UpdateParams(height int64) error
+	HandleCommunityPoolSpend(height int64, proposalID uint64, msgIndex int, recipient string, amount sdk.Coins) error
 }
 
 type StakingModule interface {
 	GetStakingPoolSnapshot(height int64) (*types.PoolSnapshot, error)
-	UpdateParams(height int64) error
 }
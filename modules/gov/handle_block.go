@@ -9,11 +9,20 @@ import (
 	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
 )
 
+// attributeKeyProposalLog represents the attribute containing the execution log of a proposal.
+// It is emitted only by x/gov v0.50 and later, so the log is not available on chains using v0.47
+const attributeKeyProposalLog = "proposal_log"
+
 // HandleBlock implements modules.BlockModule
 func (m *Module) HandleBlock(
 	b *tmctypes.ResultBlock, blockResults *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
@@ -25,6 +34,12 @@ func (m *Module) HandleBlock(
 			Err(err).Msg("error while updating proposals")
 	}
 
+	err = m.updateProposalsOutcome(b.Block.Height, blockResults.EndBlockEvents)
+	if err != nil {
+		log.Error().Str("module", "gov").Int64("height", b.Block.Height).
+			Err(err).Msg("error while updating proposals outcome")
+	}
+
 	return nil
 }
 
@@ -68,3 +83,152 @@ func (m *Module) updateProposalsStatus(height int64, events []abci.Event) error
 
 	return nil
 }
+
+// updateProposalsOutcome stores the execution result and the deposits outcome of the proposals
+// whose deposit or voting period ended inside the block having the given EndBlockEvents
+func (m *Module) updateProposalsOutcome(height int64, events []abci.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	// The deposits of each proposal are refunded or burned right before the event
+	// that marks the end of its deposit or voting period is emitted, so we collect them
+	// until we find such event
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	var refunds []types.ProposalDepositOutcome
+	var burned bool
+
+	for _, event := range events {
+		switch event.Type {
+		case banktypes.EventTypeTransfer:
+			refund, ok, err := parseDepositRefund(event, govAddress, height)
+			if err != nil {
+				return err
+			}
+			if ok {
+				refunds = append(refunds, refund)
+			}
+
+		case banktypes.EventTypeCoinBurn:
+			burner, err := juno.FindAttributeByKey(event, banktypes.AttributeKeyBurner)
+			if err == nil && burner.Value == govAddress {
+				burned = true
+			}
+
+		case govtypes.EventTypeActiveProposal, govtypes.EventTypeInactiveProposal:
+			err := m.handleProposalOutcome(height, event, refunds, burned)
+			if err != nil {
+				return err
+			}
+
+			refunds = nil
+			burned = false
+		}
+	}
+
+	return nil
+}
+
+// parseDepositRefund returns the deposit refund contained inside the given transfer event, if any.
+// If the transfer has not been sent by the gov module account, it returns false
+func parseDepositRefund(event abci.Event, govAddress string, height int64) (types.ProposalDepositOutcome, bool, error) {
+	sender, err := juno.FindAttributeByKey(event, sdk.AttributeKeySender)
+	if err != nil || sender.Value != govAddress {
+		return types.ProposalDepositOutcome{}, false, nil
+	}
+
+	recipient, err := juno.FindAttributeByKey(event, banktypes.AttributeKeyRecipient)
+	if err != nil {
+		return types.ProposalDepositOutcome{}, false, fmt.Errorf("error while getting deposit refund recipient: %s", err)
+	}
+
+	amount, err := juno.FindAttributeByKey(event, sdk.AttributeKeyAmount)
+	if err != nil {
+		return types.ProposalDepositOutcome{}, false, fmt.Errorf("error while getting deposit refund amount: %s", err)
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(amount.Value)
+	if err != nil {
+		return types.ProposalDepositOutcome{}, false, fmt.Errorf("error while parsing deposit refund amount: %s", err)
+	}
+
+	// The proposal id is set later, once the proposal event is found
+	return types.NewProposalDepositOutcome(0, recipient.Value, coins, types.DepositOutcomeRefunded, height), true, nil
+}
+
+// handleProposalOutcome stores the execution result and the deposits outcome of the proposal
+// referenced by the given active_proposal or inactive_proposal event
+func (m *Module) handleProposalOutcome(
+	height int64, event abci.Event, refunds []types.ProposalDepositOutcome, burned bool,
+) error {
+	proposalID, err := juno.FindAttributeByKey(event, govtypes.AttributeKeyProposalID)
+	if err != nil {
+		return fmt.Errorf("error while getting proposal ID from block events: %s", err)
+	}
+
+	id, err := strconv.ParseUint(proposalID.Value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error while parsing proposal id: %s", err)
+	}
+
+	result, err := juno.FindAttributeByKey(event, govtypes.AttributeKeyProposalResult)
+	if err != nil {
+		return fmt.Errorf("error while getting proposal %d result: %s", id, err)
+	}
+
+	// Store the execution result only for the proposals that passed the voting period
+	if result.Value == govtypes.AttributeValueProposalPassed || result.Value == govtypes.AttributeValueProposalFailed {
+		var executionLog string
+		if logAttr, err := juno.FindAttributeByKey(event, attributeKeyProposalLog); err == nil {
+			executionLog = logAttr.Value
+		}
+
+		success := result.Value == govtypes.AttributeValueProposalPassed
+		err = m.db.SaveProposalExecutionResult(types.NewProposalExecutionResult(id, success, executionLog, height))
+		if err != nil {
+			return fmt.Errorf("error while storing proposal %d execution result: %s", id, err)
+		}
+	}
+
+	if burned {
+		return m.saveBurnedDeposits(height, id)
+	}
+
+	for index := range refunds {
+		refunds[index].ProposalID = id
+	}
+
+	return m.db.SaveDepositOutcomes(refunds)
+}
+
+// saveBurnedDeposits marks all the deposits of the proposal having the given id as burned.
+// Burn events do not contain the depositor, so the amounts are taken from the stored deposits
+func (m *Module) saveBurnedDeposits(height int64, proposalID uint64) error {
+	deposits, err := m.db.GetProposalDeposits(proposalID)
+	if err != nil {
+		return err
+	}
+
+	var depositors []string
+	amounts := map[string]sdk.Coins{}
+	for _, deposit := range deposits {
+		// Genesis deposits do not have a depositor
+		if deposit.Depositor == "" {
+			continue
+		}
+
+		if _, ok := amounts[deposit.Depositor]; !ok {
+			depositors = append(depositors, deposit.Depositor)
+		}
+		amounts[deposit.Depositor] = amounts[deposit.Depositor].Add(deposit.Amount...)
+	}
+
+	outcomes := make([]types.ProposalDepositOutcome, len(depositors))
+	for index, depositor := range depositors {
+		outcomes[index] = types.NewProposalDepositOutcome(
+			proposalID, depositor, amounts[depositor], types.DepositOutcomeBurned, height,
+		)
+	}
+
+	return m.db.SaveDepositOutcomes(outcomes)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/forbole/bdjuno/v4/database"
 
 	govsource "github.com/forbole/bdjuno/v4/modules/gov/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 
 	"github.com/forbole/juno/v5/modules"
 )
@@ -15,6 +16,8 @@ var (
 	_ modules.GenesisModule = &Module{}
 	_ modules.BlockModule   = &Module{}
 	_ modules.MessageModule = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represent x/gov module
@@ -22,18 +25,16 @@ type Module struct {
 	cdc            codec.Codec
 	db             *database.Db
 	source         govsource.Source
+	paramsRegistry *modulestypes.ParamsRegistry
 	distrModule    DistrModule
-	mintModule     MintModule
-	slashingModule SlashingModule
 	stakingModule  StakingModule
 }
 
 // NewModule returns a new Module instance
 func NewModule(
 	source govsource.Source,
+	paramsRegistry *modulestypes.ParamsRegistry,
 	distrModule DistrModule,
-	mintModule MintModule,
-	slashingModule SlashingModule,
 	stakingModule StakingModule,
 	cdc codec.Codec,
 	db *database.Db,
@@ -41,9 +42,8 @@ func NewModule(
 	return &Module{
 		cdc:            cdc,
 		source:         source,
+		paramsRegistry: paramsRegistry,
 		distrModule:    distrModule,
-		mintModule:     mintModule,
-		slashingModule: slashingModule,
 		stakingModule:  stakingModule,
 		db:             db,
 	}
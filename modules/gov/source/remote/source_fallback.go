@@ -0,0 +1,71 @@
+package remote
+
+import (
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	govsource "github.com/forbole/bdjuno/v4/modules/gov/source"
+)
+
+var (
+	_ govsource.Source = &FallbackSource{}
+)
+
+// FallbackSource implements govsource.Source by querying the primary source first, and the fallback
+// one when the node replies that the primary queries are not implemented.
+// This allows to index chains (or heights) that only serve the legacy v1beta1 gov queries
+type FallbackSource struct {
+	primary  govsource.Source
+	fallback govsource.Source
+}
+
+// NewFallbackSource returns a new FallbackSource instance
+func NewFallbackSource(primary govsource.Source, fallback govsource.Source) *FallbackSource {
+	return &FallbackSource{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+// isUnimplemented tells whether the given error has been returned because the queried service
+// is not served by the node
+func isUnimplemented(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
+
+// Proposal implements govsource.Source
+func (s FallbackSource) Proposal(height int64, id uint64) (*govtypesv1.Proposal, error) {
+	res, err := s.primary.Proposal(height, id)
+	if isUnimplemented(err) {
+		return s.fallback.Proposal(height, id)
+	}
+	return res, err
+}
+
+// ProposalDeposit implements govsource.Source
+func (s FallbackSource) ProposalDeposit(height int64, id uint64, depositor string) (*govtypesv1.Deposit, error) {
+	res, err := s.primary.ProposalDeposit(height, id, depositor)
+	if isUnimplemented(err) {
+		return s.fallback.ProposalDeposit(height, id, depositor)
+	}
+	return res, err
+}
+
+// TallyResult implements govsource.Source
+func (s FallbackSource) TallyResult(height int64, proposalID uint64) (*govtypesv1.TallyResult, error) {
+	res, err := s.primary.TallyResult(height, proposalID)
+	if isUnimplemented(err) {
+		return s.fallback.TallyResult(height, proposalID)
+	}
+	return res, err
+}
+
+// Params implements govsource.Source
+func (s FallbackSource) Params(height int64) (*govtypesv1.Params, error) {
+	res, err := s.primary.Params(height)
+	if isUnimplemented(err) {
+		return s.fallback.Params(height)
+	}
+	return res, err
+}
@@ -0,0 +1,201 @@
+package remote_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/forbole/juno/v5/node/remote"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	remotegovsource "github.com/forbole/bdjuno/v4/modules/gov/source/remote"
+)
+
+// fakeV1beta1Server implements govtypesv1beta1.QueryServer returning fixed data
+type fakeV1beta1Server struct {
+	govtypesv1beta1.UnimplementedQueryServer
+	proposal govtypesv1beta1.Proposal
+}
+
+func (s *fakeV1beta1Server) Proposal(
+	_ context.Context, req *govtypesv1beta1.QueryProposalRequest,
+) (*govtypesv1beta1.QueryProposalResponse, error) {
+	if req.ProposalId != s.proposal.ProposalId {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	return &govtypesv1beta1.QueryProposalResponse{Proposal: s.proposal}, nil
+}
+
+func (s *fakeV1beta1Server) Deposit(
+	_ context.Context, req *govtypesv1beta1.QueryDepositRequest,
+) (*govtypesv1beta1.QueryDepositResponse, error) {
+	return &govtypesv1beta1.QueryDepositResponse{
+		Deposit: govtypesv1beta1.NewDeposit(
+			req.ProposalId,
+			sdk.MustAccAddressFromBech32(req.Depositor),
+			sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+		),
+	}, nil
+}
+
+func (s *fakeV1beta1Server) TallyResult(
+	_ context.Context, _ *govtypesv1beta1.QueryTallyResultRequest,
+) (*govtypesv1beta1.QueryTallyResultResponse, error) {
+	return &govtypesv1beta1.QueryTallyResultResponse{
+		Tally: govtypesv1beta1.NewTallyResult(sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(3), sdk.NewInt(4)),
+	}, nil
+}
+
+func (s *fakeV1beta1Server) Params(
+	_ context.Context, req *govtypesv1beta1.QueryParamsRequest,
+) (*govtypesv1beta1.QueryParamsResponse, error) {
+	switch req.ParamsType {
+	case govtypesv1beta1.ParamDeposit:
+		return &govtypesv1beta1.QueryParamsResponse{
+			DepositParams: govtypesv1beta1.NewDepositParams(
+				sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))),
+				time.Hour,
+			),
+		}, nil
+	case govtypesv1beta1.ParamVoting:
+		return &govtypesv1beta1.QueryParamsResponse{
+			VotingParams: govtypesv1beta1.NewVotingParams(2 * time.Hour),
+		}, nil
+	case govtypesv1beta1.ParamTallying:
+		return &govtypesv1beta1.QueryParamsResponse{
+			TallyParams: govtypesv1beta1.NewTallyParams(
+				sdk.NewDecWithPrec(4, 1),
+				sdk.NewDecWithPrec(5, 1),
+				sdk.NewDecWithPrec(334, 3),
+			),
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a valid parameter type", req.ParamsType)
+	}
+}
+
+// fakeV1Server implements govtypesv1.QueryServer without implementing any query,
+// as a node that does not serve the v1 gov queries would do
+type fakeV1Server struct {
+	govtypesv1.UnimplementedQueryServer
+}
+
+func buildCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	govtypesv1beta1.RegisterInterfaces(registry)
+	govtypesv1.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// buildSource starts a fake gRPC server that serves only the v1beta1 gov queries,
+// and returns a fallback source connected to it
+func buildSource(t *testing.T, proposal govtypesv1beta1.Proposal) *remotegovsource.FallbackSource {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	govtypesv1.RegisterQueryServer(server, &fakeV1Server{})
+	govtypesv1beta1.RegisterQueryServer(server, &fakeV1beta1Server{proposal: proposal})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	source := &remote.Source{Ctx: context.Background(), GrpcConn: conn}
+	return remotegovsource.NewFallbackSource(
+		remotegovsource.NewSource(source, govtypesv1.NewQueryClient(conn)),
+		remotegovsource.NewV1Beta1Source(source, govtypesv1beta1.NewQueryClient(conn), buildCodec()),
+	)
+}
+
+func buildProposal(t *testing.T) govtypesv1beta1.Proposal {
+	content := govtypesv1beta1.NewTextProposal("Title", "Description")
+	submitTime := time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC)
+
+	proposal, err := govtypesv1beta1.NewProposal(content, 1, submitTime, submitTime.Add(time.Hour))
+	require.NoError(t, err)
+
+	proposal.Status = govtypesv1beta1.StatusDepositPeriod
+	proposal.TotalDeposit = sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	return proposal
+}
+
+func TestFallbackSource_Proposal(t *testing.T) {
+	source := buildSource(t, buildProposal(t))
+
+	proposal, err := source.Proposal(10, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proposal.Id)
+	require.Equal(t, "Title", proposal.Title)
+	require.Equal(t, "Description", proposal.Summary)
+	require.Equal(t, govtypesv1.StatusDepositPeriod, proposal.Status)
+	require.Equal(t, time.Date(2020, 1, 1, 00, 00, 00, 000, time.UTC), *proposal.SubmitTime)
+	require.Nil(t, proposal.VotingStartTime)
+	require.Nil(t, proposal.VotingEndTime)
+	require.Len(t, proposal.Messages, 1)
+	require.Equal(t, "/cosmos.gov.v1.MsgExecLegacyContent", proposal.Messages[0].TypeUrl)
+
+	// Errors other than Unimplemented must be returned as they are
+	_, err = source.Proposal(10, 2)
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFallbackSource_ProposalDeposit(t *testing.T) {
+	source := buildSource(t, buildProposal(t))
+
+	depositor := "cosmos1z4hfrxvlgl4s8u4n5ngjcw8kdqrcv43599amxs"
+	deposit, err := source.ProposalDeposit(10, 1, depositor)
+	require.NoError(t, err)
+	require.Equal(t, &govtypesv1.Deposit{
+		ProposalId: 1,
+		Depositor:  depositor,
+		Amount:     sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))),
+	}, deposit)
+}
+
+func TestFallbackSource_TallyResult(t *testing.T) {
+	source := buildSource(t, buildProposal(t))
+
+	tally, err := source.TallyResult(10, 1)
+	require.NoError(t, err)
+	require.Equal(t, &govtypesv1.TallyResult{
+		YesCount:        "1",
+		AbstainCount:    "2",
+		NoCount:         "3",
+		NoWithVetoCount: "4",
+	}, tally)
+}
+
+func TestFallbackSource_Params(t *testing.T) {
+	source := buildSource(t, buildProposal(t))
+
+	params, err := source.Params(10)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))), sdk.NewCoins(params.MinDeposit...))
+	require.Equal(t, time.Hour, *params.MaxDepositPeriod)
+	require.Equal(t, 2*time.Hour, *params.VotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1).String(), params.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1).String(), params.Threshold)
+	require.Equal(t, sdk.NewDecWithPrec(334, 3).String(), params.VetoThreshold)
+}
@@ -0,0 +1,100 @@
+package remote
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/forbole/juno/v5/node/remote"
+
+	govsource "github.com/forbole/bdjuno/v4/modules/gov/source"
+)
+
+var (
+	_ govsource.Source = &V1Beta1Source{}
+)
+
+// V1Beta1Source implements govsource.Source using a remote node that only serves the legacy v1beta1 queries.
+// All the returned values are converted to their v1 representation
+type V1Beta1Source struct {
+	*remote.Source
+	queryClient govtypesv1beta1.QueryClient
+	cdc         codec.Codec
+}
+
+// NewV1Beta1Source returns a new V1Beta1Source implementation
+func NewV1Beta1Source(source *remote.Source, queryClient govtypesv1beta1.QueryClient, cdc codec.Codec) *V1Beta1Source {
+	return &V1Beta1Source{
+		Source:      source,
+		queryClient: queryClient,
+		cdc:         cdc,
+	}
+}
+
+// Proposal implements govsource.Source
+func (s V1Beta1Source) Proposal(height int64, id uint64) (*govtypesv1.Proposal, error) {
+	res, err := s.queryClient.Proposal(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&govtypesv1beta1.QueryProposalRequest{ProposalId: id},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertProposal(s.cdc, res.Proposal)
+}
+
+// ProposalDeposit implements govsource.Source
+func (s V1Beta1Source) ProposalDeposit(height int64, id uint64, depositor string) (*govtypesv1.Deposit, error) {
+	res, err := s.queryClient.Deposit(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&govtypesv1beta1.QueryDepositRequest{ProposalId: id, Depositor: depositor},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertDeposit(res.Deposit), nil
+}
+
+// TallyResult implements govsource.Source
+func (s V1Beta1Source) TallyResult(height int64, proposalID uint64) (*govtypesv1.TallyResult, error) {
+	res, err := s.queryClient.TallyResult(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&govtypesv1beta1.QueryTallyResultRequest{ProposalId: proposalID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertTallyResult(res.Tally), nil
+}
+
+// Params implements govsource.Source
+func (s V1Beta1Source) Params(height int64) (*govtypesv1.Params, error) {
+	// v1beta1 returns only one set of params for each query, so we need to query all of them
+	depositRes, err := s.queryClient.Params(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&govtypesv1beta1.QueryParamsRequest{ParamsType: govtypesv1beta1.ParamDeposit},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	votingRes, err := s.queryClient.Params(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&govtypesv1beta1.QueryParamsRequest{ParamsType: govtypesv1beta1.ParamVoting},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tallyRes, err := s.queryClient.Params(
+		remote.GetHeightRequestContext(s.Ctx, height),
+		&govtypesv1beta1.QueryParamsRequest{ParamsType: govtypesv1beta1.ParamTallying},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConvertParams(depositRes.DepositParams, votingRes.VotingParams, tallyRes.TallyParams), nil
+}
@@ -0,0 +1,96 @@
+package remote
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// ConvertProposal converts the given v1beta1 proposal into a v1 proposal.
+// The legacy content is wrapped inside a single MsgExecLegacyContent message, as done by the x/gov migrations
+func ConvertProposal(cdc codec.Codec, proposal govtypesv1beta1.Proposal) (*govtypesv1.Proposal, error) {
+	var content govtypesv1beta1.Content
+	err := cdc.UnpackAny(proposal.Content, &content)
+	if err != nil {
+		return nil, fmt.Errorf("error while unpacking proposal %d content: %s", proposal.ProposalId, err)
+	}
+
+	msg := govtypesv1.NewMsgExecLegacyContent(proposal.Content, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, fmt.Errorf("error while packing proposal %d content: %s", proposal.ProposalId, err)
+	}
+
+	tally := ConvertTallyResult(proposal.FinalTallyResult)
+	return &govtypesv1.Proposal{
+		Id:               proposal.ProposalId,
+		Messages:         []*codectypes.Any{msgAny},
+		Status:           govtypesv1.ProposalStatus(proposal.Status),
+		FinalTallyResult: tally,
+		SubmitTime:       timeToPointer(proposal.SubmitTime),
+		DepositEndTime:   timeToPointer(proposal.DepositEndTime),
+		TotalDeposit:     proposal.TotalDeposit,
+		VotingStartTime:  timeToPointer(proposal.VotingStartTime),
+		VotingEndTime:    timeToPointer(proposal.VotingEndTime),
+		Title:            content.GetTitle(),
+		Summary:          content.GetDescription(),
+	}, nil
+}
+
+// timeToPointer returns a pointer to the given time, or nil if the time is not set.
+// v1beta1 proposals that are still in deposit period have zero voting times, while v1 ones have nil
+func timeToPointer(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// ConvertDeposit converts the given v1beta1 deposit into a v1 deposit
+func ConvertDeposit(deposit govtypesv1beta1.Deposit) *govtypesv1.Deposit {
+	return &govtypesv1.Deposit{
+		ProposalId: deposit.ProposalId,
+		Depositor:  deposit.Depositor,
+		Amount:     deposit.Amount,
+	}
+}
+
+// ConvertTallyResult converts the given v1beta1 tally result into a v1 tally result
+func ConvertTallyResult(tally govtypesv1beta1.TallyResult) *govtypesv1.TallyResult {
+	return &govtypesv1.TallyResult{
+		YesCount:        tally.Yes.String(),
+		AbstainCount:    tally.Abstain.String(),
+		NoCount:         tally.No.String(),
+		NoWithVetoCount: tally.NoWithVeto.String(),
+	}
+}
+
+// ConvertParams merges the given v1beta1 params into a single v1 params instance
+func ConvertParams(
+	depositParams govtypesv1beta1.DepositParams,
+	votingParams govtypesv1beta1.VotingParams,
+	tallyParams govtypesv1beta1.TallyParams,
+) *govtypesv1.Params {
+	return &govtypesv1.Params{
+		MinDeposit:             depositParams.MinDeposit,
+		MaxDepositPeriod:       &depositParams.MaxDepositPeriod,
+		VotingPeriod:           &votingParams.VotingPeriod,
+		Quorum:                 tallyParams.Quorum.String(),
+		Threshold:              tallyParams.Threshold.String(),
+		VetoThreshold:          tallyParams.VetoThreshold.String(),
+		MinInitialDepositRatio: sdk.ZeroDec().String(),
+
+		// Before v1, deposits were always burned when a proposal was vetoed, did not reach the
+		// quorum or did not reach the min deposit before the end of the deposit period
+		BurnVoteQuorum:             true,
+		BurnProposalDepositPrevote: true,
+		BurnVoteVeto:               true,
+	}
+}
@@ -3,8 +3,12 @@ package gov
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/rs/zerolog/log"
 
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -20,3 +24,13 @@ func (m *Module) UpdateParams(height int64) error {
 
 	return m.db.SaveGovParams(types.NewGovParams(params, height))
 }
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      gov.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&govtypesv1.MsgUpdateParams{})},
+		Subspaces:   []string{gov.ModuleName},
+		Refresh:     m.UpdateParams,
+	}
+}
@@ -6,21 +6,17 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/rs/zerolog/log"
 
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
-	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"google.golang.org/grpc/codes"
 
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/types"
-
-	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // UpdateProposalStatus queries the latest details of given proposal ID, updates it's status
@@ -118,40 +114,17 @@ func (m *Module) updateDeletedProposalStatus(id uint64) error {
 	)
 }
 
-// handleParamChangeProposal updates params to the corresponding modules if a ParamChangeProposal has passed
-func (m *Module) handleParamChangeProposal(height int64, moduleName string) (err error) {
-	switch moduleName {
-	case distrtypes.ModuleName:
-		err = m.distrModule.UpdateParams(height)
-		if err != nil {
-			return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", distrtypes.ModuleName, err)
-		}
-	case gov.ModuleName:
-		err = m.UpdateParams(height)
-		if err != nil {
-			return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", gov.ModuleName, err)
-		}
-	case minttypes.ModuleName:
-		err = m.mintModule.UpdateParams(height)
-		if err != nil {
-			return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", minttypes.ModuleName, err)
-		}
+// handleParamChangeProposal refreshes the params of the module that owns them if a param change proposal has passed,
+// and links the new params to the proposal that changed them
+func (m *Module) handleParamChangeProposal(height int64, proposalID uint64, registration modulestypes.ParamsRegistration) error {
+	err := registration.Refresh(height)
+	if err != nil {
+		return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", registration.Module, err)
+	}
 
-		// Update the inflation
-		err = m.mintModule.UpdateInflation()
-		if err != nil {
-			return fmt.Errorf("error while updating inflation with ParamChangeProposal: %s", err)
-		}
-	case slashingtypes.ModuleName:
-		err = m.slashingModule.UpdateParams(height)
-		if err != nil {
-			return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", slashingtypes.ModuleName, err)
-		}
-	case stakingtypes.ModuleName:
-		err = m.stakingModule.UpdateParams(height)
-		if err != nil {
-			return fmt.Errorf("error while updating ParamChangeProposal %s params : %s", stakingtypes.ModuleName, err)
-		}
+	err = m.db.SetModuleParamsProposal(registration.Module, height, proposalID)
+	if err != nil {
+		return fmt.Errorf("error while setting ParamChangeProposal %s params proposal: %s", registration.Module, err)
 	}
 
 	return nil
@@ -187,16 +160,55 @@ func (m *Module) UpdateProposalTallyResult(proposalID uint64, height int64) erro
 		return fmt.Errorf("error while getting tally result: %s", err)
 	}
 
-	return m.db.SaveTallyResults([]types.TallyResult{
-		types.NewTallyResult(
-			proposalID,
-			result.YesCount,
-			result.AbstainCount,
-			result.NoCount,
-			result.NoWithVetoCount,
-			height,
-		),
-	})
+	tally := types.NewTallyResult(
+		proposalID,
+		result.YesCount,
+		result.AbstainCount,
+		result.NoCount,
+		result.NoWithVetoCount,
+		height,
+	)
+
+	err = m.db.SaveTallyResults([]types.TallyResult{tally})
+	if err != nil {
+		return err
+	}
+
+	return m.updateProposalTallyStatus(tally)
+}
+
+// updateProposalTallyStatus evaluates the given tally against the gov params in effect at the tally height and the
+// staking pool snapshot of the proposal, and stores the resulting status
+func (m *Module) updateProposalTallyStatus(tally types.TallyResult) error {
+	params, err := m.db.GetGovParamsAtHeight(tally.Height)
+	if err != nil {
+		return fmt.Errorf("error while getting gov params: %s", err)
+	}
+
+	if params == nil {
+		// Params have not been stored yet, so we can't evaluate the tally
+		return nil
+	}
+
+	pool, err := m.db.GetProposalStakingPoolSnapshot(tally.ProposalID)
+	if err != nil {
+		return err
+	}
+
+	if pool == nil {
+		// Use the current staking pool if the snapshot has not been stored yet
+		pool, err = m.stakingModule.GetStakingPoolSnapshot(tally.Height)
+		if err != nil {
+			return fmt.Errorf("error while getting staking pool snapshot: %s", err)
+		}
+	}
+
+	status, err := types.ComputeProposalTallyStatus(tally, params.Params, pool.BondedTokens)
+	if err != nil {
+		return fmt.Errorf("error while computing tally status: %s", err)
+	}
+
+	return m.db.SaveProposalTallyStatus(status)
 }
 
 func (m *Module) handlePassedProposal(proposal *govtypesv1.Proposal, height int64) error {
@@ -205,7 +217,7 @@ func (m *Module) handlePassedProposal(proposal *govtypesv1.Proposal, height int6
 		return nil
 	}
 
-	for _, msg := range proposal.Messages {
+	for index, msg := range proposal.Messages {
 		var sdkMsg sdk.Msg
 		err := m.cdc.UnpackAny(msg, &sdkMsg)
 		if err != nil {
@@ -214,13 +226,13 @@ func (m *Module) handlePassedProposal(proposal *govtypesv1.Proposal, height int6
 
 		switch msg := sdkMsg.(type) {
 		case *govtypesv1.MsgExecLegacyContent:
-			err := m.handlePassedV1Beta1Proposal(proposal, msg, height)
+			err := m.handlePassedV1Beta1Proposal(proposal, index, msg, height)
 			if err != nil {
 				return err
 			}
 
 		default:
-			err := m.handlePassedV1Proposal(proposal, msg, height)
+			err := m.handlePassedV1Proposal(proposal, index, msg, height)
 			if err != nil {
 				return err
 			}
@@ -231,7 +243,7 @@ func (m *Module) handlePassedProposal(proposal *govtypesv1.Proposal, height int6
 }
 
 // handlePassedV1Proposal handles a passed proposal that contains a v1 message (new version)
-func (m *Module) handlePassedV1Proposal(proposal *govtypesv1.Proposal, msg sdk.Msg, height int64) error {
+func (m *Module) handlePassedV1Proposal(proposal *govtypesv1.Proposal, index int, msg sdk.Msg, height int64) error {
 	switch msg := msg.(type) {
 	case *upgradetypes.MsgSoftwareUpgrade:
 		// Store software upgrade plan while SoftwareUpgradeProposal passed
@@ -247,12 +259,19 @@ func (m *Module) handlePassedV1Proposal(proposal *govtypesv1.Proposal, msg sdk.M
 			return fmt.Errorf("error while deleting software upgrade plan: %s", err)
 		}
 
+	case *distrtypes.MsgCommunityPoolSpend:
+		// Store the community pool spend while CommunityPoolSpendProposal passed
+		err := m.distrModule.HandleCommunityPoolSpend(height, proposal.Id, index, msg.Recipient, msg.Amount)
+		if err != nil {
+			return fmt.Errorf("error while handling community pool spend: %s", err)
+		}
+
 	default:
 		// Try to see if it's a param change proposal. This should be handled as last case
 		// because it's the most generic one
-		subspace, ok := getParamChangeSubspace(msg)
+		registration, ok := m.paramsRegistry.GetByMsgTypeURL(sdk.MsgTypeURL(msg))
 		if ok {
-			err := m.handleParamChangeProposal(height, subspace)
+			err := m.handleParamChangeProposal(height, proposal.Id, registration)
 			if err != nil {
 				return err
 			}
@@ -262,28 +281,10 @@ func (m *Module) handlePassedV1Proposal(proposal *govtypesv1.Proposal, msg sdk.M
 	return nil
 }
 
-// getParamChangeSubspace returns the subspace of the param change proposal, if any.
-// If the message is not a param change proposal, it returns false
-func getParamChangeSubspace(msg sdk.Msg) (string, bool) {
-	switch msg.(type) {
-	case *distrtypes.MsgUpdateParams:
-		return distrtypes.ModuleName, true
-	case *govtypesv1.MsgUpdateParams:
-		return gov.ModuleName, true
-	case *minttypes.MsgUpdateParams:
-		return minttypes.ModuleName, true
-	case *slashingtypes.MsgUpdateParams:
-		return slashingtypes.ModuleName, true
-	case *stakingtypes.MsgUpdateParams:
-		return stakingtypes.ModuleName, true
-
-	default:
-		return "", false
-	}
-}
-
 // handlePassedV1Beta1Proposal handles a passed proposal with a v1beta1 message (legacy)
-func (m *Module) handlePassedV1Beta1Proposal(proposal *govtypesv1.Proposal, msg *govtypesv1.MsgExecLegacyContent, height int64) error {
+func (m *Module) handlePassedV1Beta1Proposal(
+	proposal *govtypesv1.Proposal, index int, msg *govtypesv1.MsgExecLegacyContent, height int64,
+) error {
 	// Unpack proposal
 	var content govtypesv1beta1.Content
 	var protoCodec codec.ProtoCodec
@@ -294,12 +295,19 @@ func (m *Module) handlePassedV1Beta1Proposal(proposal *govtypesv1.Proposal, msg
 
 	switch p := content.(type) {
 	case *proposaltypes.ParameterChangeProposal:
-		// Update params while ParameterChangeProposal passed
+		// Update params while ParameterChangeProposal passed, refreshing each module only once
+		refreshed := map[string]bool{}
 		for _, change := range p.Changes {
-			err = m.handleParamChangeProposal(height, change.Subspace)
+			registration, ok := m.paramsRegistry.GetBySubspace(change.Subspace)
+			if !ok || refreshed[registration.Module] {
+				continue
+			}
+
+			err = m.handleParamChangeProposal(height, proposal.Id, registration)
 			if err != nil {
 				return fmt.Errorf("error while updating params from ParamChangeProposal: %s", err)
 			}
+			refreshed[registration.Module] = true
 		}
 	case *upgradetypes.SoftwareUpgradeProposal:
 		// Store software upgrade plan while SoftwareUpgradeProposal passed
@@ -313,6 +321,12 @@ func (m *Module) handlePassedV1Beta1Proposal(proposal *govtypesv1.Proposal, msg
 		if err != nil {
 			return fmt.Errorf("error while deleting software upgrade plan: %s", err)
 		}
+	case *distrtypes.CommunityPoolSpendProposal:
+		// Store the community pool spend while CommunityPoolSpendProposal passed
+		err = m.distrModule.HandleCommunityPoolSpend(height, proposal.Id, index, p.Recipient, p.Amount)
+		if err != nil {
+			return fmt.Errorf("error while handling community pool spend: %s", err)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,88 @@
+package mint
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleBlock implements modules.BlockModule
+func (m *Module) HandleBlock(
+	b *tmctypes.ResultBlock, blockResults *tmctypes.ResultBlockResults, _ []*juno.Tx, _ *tmctypes.ResultValidators,
+) error {
+	err := m.updateBlockProvision(b.Block.Height, blockResults.BeginBlockEvents)
+	if err != nil {
+		log.Error().Str("module", "mint").Int64("height", b.Block.Height).
+			Err(err).Msg("error while updating block provision")
+	}
+
+	return nil
+}
+
+// updateBlockProvision stores the data of the mint event emitted during the BeginBlock of the given height, if any
+func (m *Module) updateBlockProvision(height int64, events []abci.Event) error {
+	event, err := juno.FindEventByType(events, minttypes.EventTypeMint)
+	if err != nil {
+		// No mint event, nothing to store
+		return nil
+	}
+
+	provision, err := ParseBlockProvision(event, height)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveBlockProvision(provision)
+}
+
+// ParseBlockProvision parses the given mint event into a BlockProvision
+func ParseBlockProvision(event abci.Event, height int64) (types.BlockProvision, error) {
+	bondedRatio, err := parseDecAttribute(event, minttypes.AttributeKeyBondedRatio)
+	if err != nil {
+		return types.BlockProvision{}, err
+	}
+
+	inflation, err := parseDecAttribute(event, minttypes.AttributeKeyInflation)
+	if err != nil {
+		return types.BlockProvision{}, err
+	}
+
+	annualProvisions, err := parseDecAttribute(event, minttypes.AttributeKeyAnnualProvisions)
+	if err != nil {
+		return types.BlockProvision{}, err
+	}
+
+	amountAttr, err := juno.FindAttributeByKey(event, sdk.AttributeKeyAmount)
+	if err != nil {
+		return types.BlockProvision{}, fmt.Errorf("error while getting minted amount: %s", err)
+	}
+
+	amount, ok := sdk.NewIntFromString(amountAttr.Value)
+	if !ok {
+		return types.BlockProvision{}, fmt.Errorf("invalid minted amount: %s", amountAttr.Value)
+	}
+
+	return types.NewBlockProvision(bondedRatio, inflation, annualProvisions, amount, height), nil
+}
+
+// parseDecAttribute returns the value of the attribute having the given key, parsed as a sdk.Dec
+func parseDecAttribute(event abci.Event, key string) (sdk.Dec, error) {
+	attr, err := juno.FindAttributeByKey(event, key)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("error while getting %s: %s", key, err)
+	}
+
+	value, err := sdk.NewDecFromStr(attr.Value)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("error while parsing %s: %s", key, err)
+	}
+
+	return value, nil
+}
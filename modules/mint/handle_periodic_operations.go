@@ -18,6 +18,12 @@ func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
 		return err
 	}
 
+	if _, err := scheduler.Every(1).Day().At("00:00").Do(func() {
+		utils.WatchMethod(m.UpdateAnnualProvisions)
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -42,3 +48,23 @@ func (m *Module) UpdateInflation() error {
 
 	return m.db.SaveInflation(inflation, block.Height)
 }
+
+// UpdateAnnualProvisions fetches the latest value of the annual provisions and saves it inside the database
+func (m *Module) UpdateAnnualProvisions() error {
+	log.Debug().
+		Str("module", "mint").
+		Str("operation", "annual provisions").
+		Msg("getting annual provisions data")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	annualProvisions, err := m.source.AnnualProvisions(block.Height)
+	if err != nil {
+		return err
+	}
+
+	return m.db.SaveAnnualProvisions(annualProvisions, block.Height)
+}
@@ -6,12 +6,16 @@ import (
 
 	"github.com/forbole/bdjuno/v4/database"
 	mintsource "github.com/forbole/bdjuno/v4/modules/mint/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
 var (
 	_ modules.Module                   = &Module{}
 	_ modules.GenesisModule            = &Module{}
+	_ modules.BlockModule              = &Module{}
 	_ modules.PeriodicOperationsModule = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represent database/mint module
@@ -43,6 +43,21 @@ func (s Source) GetInflation(height int64) (sdk.Dec, error) {
 	return res.Inflation, nil
 }
 
+// AnnualProvisions implements mintsource.Source
+func (s Source) AnnualProvisions(height int64) (sdk.Dec, error) {
+	ctx, err := s.LoadHeight(height)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("error while loading height: %s", err)
+	}
+
+	res, err := s.querier.AnnualProvisions(sdk.WrapSDKContext(ctx), &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return res.AnnualProvisions, nil
+}
+
 // Params implements mintsource.Source
 func (s Source) Params(height int64) (minttypes.Params, error) {
 	ctx, err := s.LoadHeight(height)
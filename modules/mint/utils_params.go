@@ -3,8 +3,11 @@ package mint
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/rs/zerolog/log"
 
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -21,3 +24,28 @@ func (m *Module) UpdateParams(height int64) error {
 	return m.db.SaveMintParams(types.NewMintParams(params, height))
 
 }
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      minttypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&minttypes.MsgUpdateParams{})},
+		Subspaces:   []string{minttypes.ModuleName},
+		Refresh:     m.refreshParams,
+	}
+}
+
+// refreshParams updates the params as well as the inflation, which depends on them
+func (m *Module) refreshParams(height int64) error {
+	err := m.UpdateParams(height)
+	if err != nil {
+		return err
+	}
+
+	err = m.UpdateInflation()
+	if err != nil {
+		return fmt.Errorf("error while updating inflation: %s", err)
+	}
+
+	return nil
+}
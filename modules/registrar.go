@@ -19,9 +19,11 @@ import (
 
 	"github.com/forbole/bdjuno/v4/database"
 	"github.com/forbole/bdjuno/v4/modules/auth"
+	"github.com/forbole/bdjuno/v4/modules/authz"
 	"github.com/forbole/bdjuno/v4/modules/bank"
 	"github.com/forbole/bdjuno/v4/modules/consensus"
 	"github.com/forbole/bdjuno/v4/modules/distribution"
+	"github.com/forbole/bdjuno/v4/modules/events"
 	"github.com/forbole/bdjuno/v4/modules/feegrant"
 
 	dailyrefetch "github.com/forbole/bdjuno/v4/modules/daily_refetch"
@@ -30,6 +32,7 @@ import (
 	"github.com/forbole/bdjuno/v4/modules/modules"
 	"github.com/forbole/bdjuno/v4/modules/pricefeed"
 	"github.com/forbole/bdjuno/v4/modules/staking"
+	"github.com/forbole/bdjuno/v4/modules/stats"
 	"github.com/forbole/bdjuno/v4/modules/upgrade"
 )
 
@@ -73,19 +76,36 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 		panic(err)
 	}
 
-	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig)
-	authModule := auth.NewModule(r.parser, cdc, db)
+	paramsRegistry := types.NewParamsRegistry()
+
+	actionsModule := actions.NewModule(ctx.JunoConfig, ctx.EncodingConfig, db)
+	authModule := auth.NewModule(ctx.JunoConfig, sources.AuthSource, r.parser, cdc, db)
+	authzModule := authz.NewModule(sources.AuthzSource, cdc, db)
 	bankModule := bank.NewModule(r.parser, sources.BankSource, cdc, db)
-	consensusModule := consensus.NewModule(db)
+	consensusModule := consensus.NewModule(sources.ConsensusSource, db)
 	dailyRefetchModule := dailyrefetch.NewModule(ctx.Proxy, db)
-	distrModule := distribution.NewModule(sources.DistrSource, cdc, db)
-	feegrantModule := feegrant.NewModule(cdc, db)
+	distrModule := distribution.NewModule(ctx.JunoConfig, sources.DistrSource, cdc, db)
+	eventsModule := events.NewModule(ctx.JunoConfig, db)
+	feegrantModule := feegrant.NewModule(sources.FeegrantSource, cdc, db)
 	mintModule := mint.NewModule(sources.MintSource, cdc, db)
 	slashingModule := slashing.NewModule(sources.SlashingSource, cdc, db)
 	stakingModule := staking.NewModule(sources.StakingSource, cdc, db)
-	govModule := gov.NewModule(sources.GovSource, distrModule, mintModule, slashingModule, stakingModule, cdc, db)
+	statsModule := stats.NewModule(ctx.EncodingConfig.InterfaceRegistry, db)
+	govModule := gov.NewModule(sources.GovSource, paramsRegistry, distrModule, stakingModule, cdc, db)
 	upgradeModule := upgrade.NewModule(db, stakingModule)
 
+	// Register all the modules owning some params, so that they are refreshed when changed by governance
+	paramsRegistry.Register(
+		authModule,
+		bankModule,
+		consensusModule,
+		distrModule,
+		govModule,
+		mintModule,
+		slashingModule,
+		stakingModule,
+	)
+
 	return []jmodules.Module{
 		messages.NewModule(r.parser, cdc, ctx.Database),
 		telemetry.NewModule(ctx.JunoConfig),
@@ -93,10 +113,12 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 
 		actionsModule,
 		authModule,
+		authzModule,
 		bankModule,
 		consensusModule,
 		dailyRefetchModule,
 		distrModule,
+		eventsModule,
 		feegrantModule,
 		govModule,
 		mintModule,
@@ -104,6 +126,7 @@ func (r *Registrar) BuildModules(ctx registrar.Context) jmodules.Modules {
 		pricefeed.NewModule(ctx.JunoConfig, cdc, db),
 		slashingModule,
 		stakingModule,
+		statsModule,
 		upgradeModule,
 	}
 }
@@ -6,12 +6,15 @@ import (
 
 	"github.com/forbole/bdjuno/v4/database"
 	slashingsource "github.com/forbole/bdjuno/v4/modules/slashing/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
 var (
 	_ modules.Module        = &Module{}
 	_ modules.GenesisModule = &Module{}
 	_ modules.BlockModule   = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represent x/slashing module
@@ -3,8 +3,11 @@ package slashing
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/rs/zerolog/log"
 
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -20,3 +23,13 @@ func (m *Module) UpdateParams(height int64) error {
 	return m.db.SaveSlashingParams(types.NewSlashingParams(params, height))
 
 }
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      slashingtypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&slashingtypes.MsgUpdateParams{})},
+		Subspaces:   []string{slashingtypes.ModuleName},
+		Refresh:     m.UpdateParams,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/forbole/bdjuno/v4/database"
 	stakingsource "github.com/forbole/bdjuno/v4/modules/staking/source"
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 )
 
 var (
@@ -14,6 +15,8 @@ var (
 	_ modules.BlockModule              = &Module{}
 	_ modules.MessageModule            = &Module{}
 	_ modules.PeriodicOperationsModule = &Module{}
+
+	_ modulestypes.ParamsModule = &Module{}
 )
 
 // Module represents the x/staking module
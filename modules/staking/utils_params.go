@@ -3,8 +3,11 @@ package staking
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/rs/zerolog/log"
 
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
 	"github.com/forbole/bdjuno/v4/types"
 )
 
@@ -20,3 +23,13 @@ func (m *Module) UpdateParams(height int64) error {
 
 	return m.db.SaveStakingParams(types.NewStakingParams(params, height))
 }
+
+// ParamsRegistration implements modulestypes.ParamsModule
+func (m *Module) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      stakingtypes.ModuleName,
+		MsgTypeURLs: []string{sdk.MsgTypeURL(&stakingtypes.MsgUpdateParams{})},
+		Subspaces:   []string{stakingtypes.ModuleName},
+		Refresh:     m.UpdateParams,
+	}
+}
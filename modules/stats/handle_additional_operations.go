@@ -0,0 +1,19 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RunAdditionalOperations implements modules.AdditionalOperationsModule
+func (m *Module) RunAdditionalOperations() error {
+	log.Debug().Str("module", "stats").Msg("storing registered message types")
+
+	err := m.RefreshRegisteredMessageTypes()
+	if err != nil {
+		return fmt.Errorf("error while storing registered message types: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/go-co-op/gocron"
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/modules/utils"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// RegisterPeriodicOperations implements modules.PeriodicOperationsModule
+func (m *Module) RegisterPeriodicOperations(scheduler *gocron.Scheduler) error {
+	log.Debug().Str("module", "stats").Msg("setting up periodic tasks")
+
+	if _, err := scheduler.Every(10).Minutes().Do(func() {
+		utils.WatchMethod(m.updateRollups)
+	}); err != nil {
+		return fmt.Errorf("error while setting up stats periodic operation: %s", err)
+	}
+
+	return nil
+}
+
+// updateRollups refreshes the rollups of the periods containing the latest stored block,
+// as well as the previous ones so that they are completed once all their blocks have been stored.
+// The message types usage is then updated using the refreshed daily rollups
+func (m *Module) updateRollups() error {
+	log.Trace().Str("module", "stats").Str("operation", "rollups").
+		Msg("updating chain stats rollups")
+
+	block, err := m.db.GetLastBlockHeightAndTimestamp()
+	if err != nil {
+		return err
+	}
+
+	// Skip if no block has been stored yet
+	if block.Height == 0 {
+		return nil
+	}
+
+	for _, period := range []types.StatsPeriod{types.StatsPeriodHour, types.StatsPeriodDay} {
+		current := period.Truncate(block.BlockTimestamp)
+		previous := period.Truncate(current.Add(-1))
+
+		err = m.RebuildRollups(period, previous, current)
+		if err != nil {
+			return err
+		}
+	}
+
+	today := types.StatsPeriodDay.Truncate(block.BlockTimestamp)
+	return m.db.UpdateMessageTypesUsage(types.StatsPeriodDay.Truncate(today.Add(-1)))
+}
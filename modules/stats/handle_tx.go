@@ -0,0 +1,47 @@
+package stats
+
+import (
+	"errors"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	juno "github.com/forbole/juno/v5/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// HandleTx implements modules.TransactionModule
+func (m *Module) HandleTx(tx *juno.Tx) error {
+	if tx.Successful() {
+		return nil
+	}
+
+	return m.db.SaveTransactionError(types.NewTransactionError(
+		tx.TxHash, tx.Height, tx.Codespace, tx.Code, GetErrorDescription(tx.Codespace, tx.Code), GetMessageTypes(tx),
+	))
+}
+
+// GetMessageTypes returns the distinct types of the messages included inside the given transaction,
+// using the same proto names stored inside the message table
+func GetMessageTypes(tx *juno.Tx) []string {
+	seen := map[string]bool{}
+	msgTypes := []string{}
+	for _, msg := range tx.Body.Messages {
+		msgType := strings.TrimPrefix(msg.TypeUrl, "/")
+		if !seen[msgType] {
+			seen[msgType] = true
+			msgTypes = append(msgTypes, msgType)
+		}
+	}
+	return msgTypes
+}
+
+// GetErrorDescription returns the description of the error registered with the given codespace and code,
+// or "unknown" if no such error has been registered
+func GetErrorDescription(codespace string, code uint32) string {
+	var registered *sdkerrors.Error
+	if errors.As(sdkerrors.ABCIError(codespace, code, ""), &registered) {
+		return registered.Error()
+	}
+	return "unknown"
+}
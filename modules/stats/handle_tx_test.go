@@ -0,0 +1,31 @@
+package stats_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	juno "github.com/forbole/juno/v5/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/stats"
+)
+
+func TestGetErrorDescription(t *testing.T) {
+	require.Equal(t, "insufficient funds", stats.GetErrorDescription("sdk", 5))
+	require.Equal(t, "out of gas", stats.GetErrorDescription("sdk", 11))
+	require.Equal(t, "unknown", stats.GetErrorDescription("not-registered", 1))
+}
+
+func TestGetMessageTypes(t *testing.T) {
+	junoTx := &juno.Tx{Tx: &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate"},
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+	}}}}
+
+	require.Equal(t, []string{
+		"cosmos.bank.v1beta1.MsgSend",
+		"cosmos.staking.v1beta1.MsgDelegate",
+	}, stats.GetMessageTypes(junoTx))
+}
@@ -0,0 +1,35 @@
+package stats
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/forbole/juno/v5/modules"
+
+	"github.com/forbole/bdjuno/v4/database"
+)
+
+var (
+	_ modules.Module                     = &Module{}
+	_ modules.PeriodicOperationsModule   = &Module{}
+	_ modules.TransactionModule          = &Module{}
+	_ modules.AdditionalOperationsModule = &Module{}
+)
+
+// Module represents the module that computes the chain stats rollups, classifies the failed transactions
+// and keeps track of the message types usage
+type Module struct {
+	registry codectypes.InterfaceRegistry
+	db       *database.Db
+}
+
+// NewModule builds a new Module instance
+func NewModule(registry codectypes.InterfaceRegistry, db *database.Db) *Module {
+	return &Module{
+		registry: registry,
+		db:       db,
+	}
+}
+
+// Name implements modules.Module
+func (m *Module) Name() string {
+	return "stats"
+}
@@ -0,0 +1,41 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// GetRegisteredMessageTypes returns all the message types registered inside the given interface registry,
+// sorted by type
+func GetRegisteredMessageTypes(registry codectypes.InterfaceRegistry) []types.MessageType {
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(typeURLs)
+
+	msgTypes := make([]types.MessageType, len(typeURLs))
+	for i, typeURL := range typeURLs {
+		// The message table stores the proto names, which are the type URLs without the leading "/"
+		msgTypes[i] = types.NewMessageType(strings.TrimPrefix(typeURL, "/"))
+	}
+	return msgTypes
+}
+
+// RefreshRegisteredMessageTypes stores all the message types registered inside the application interface registry
+func (m *Module) RefreshRegisteredMessageTypes() error {
+	return m.db.SaveRegisteredMessageTypes(GetRegisteredMessageTypes(m.registry))
+}
+
+// RebuildMessageTypes stores all the registered message types and computes again their usage
+// from the stored messages
+func (m *Module) RebuildMessageTypes() error {
+	err := m.RefreshRegisteredMessageTypes()
+	if err != nil {
+		return err
+	}
+
+	return m.db.RebuildMessageTypesUsage()
+}
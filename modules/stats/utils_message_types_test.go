@@ -0,0 +1,24 @@
+package stats_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/forbole/bdjuno/v4/modules/stats"
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+func TestGetRegisteredMessageTypes(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+
+	msgTypes := stats.GetRegisteredMessageTypes(registry)
+	require.Contains(t, msgTypes, types.NewMessageType("cosmos.bank.v1beta1.MsgSend"))
+	require.Contains(t, msgTypes, types.NewMessageType("cosmos.bank.v1beta1.MsgMultiSend"))
+	require.Equal(t, "cosmos.bank.v1beta1", msgTypes[0].Package)
+}
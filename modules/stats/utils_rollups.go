@@ -0,0 +1,26 @@
+package stats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/forbole/bdjuno/v4/types"
+)
+
+// RebuildRollups computes again the rollups of all the periods of the given type
+// containing at least one instant between from and to (both included)
+func (m *Module) RebuildRollups(period types.StatsPeriod, from, to time.Time) error {
+	for _, start := range period.Starts(from, to) {
+		log.Debug().Str("module", "stats").Str("period", string(period)).Time("start", start).
+			Msg("computing chain stats rollup")
+
+		err := m.db.SaveChainStats(period, start)
+		if err != nil {
+			return fmt.Errorf("error while computing %s stats starting at %s: %s", period, start, err)
+		}
+	}
+
+	return nil
+}
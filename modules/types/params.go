@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ParamsRegistration contains the data that a module registers so that its params
+// can be refreshed when they are changed by a governance proposal
+type ParamsRegistration struct {
+	// Module is the name of the module that owns the params
+	Module string
+
+	// MsgTypeURLs contains the type URLs of the MsgUpdateParams messages owned by the module
+	MsgTypeURLs []string
+
+	// Subspaces contains the legacy x/params subspaces owned by the module
+	Subspaces []string
+
+	// Refresh refreshes the params of the module at the given height
+	Refresh func(height int64) error
+}
+
+// ParamsModule represents a module that owns some params that can be changed by governance
+type ParamsModule interface {
+	ParamsRegistration() ParamsRegistration
+}
+
+// ParamsRegistry contains the params registrations of all the modules
+type ParamsRegistry struct {
+	registrations []ParamsRegistration
+	byMsgTypeURL  map[string]ParamsRegistration
+	bySubspace    map[string]ParamsRegistration
+}
+
+// NewParamsRegistry returns a new empty ParamsRegistry instance
+func NewParamsRegistry() *ParamsRegistry {
+	return &ParamsRegistry{
+		byMsgTypeURL: make(map[string]ParamsRegistration),
+		bySubspace:   make(map[string]ParamsRegistration),
+	}
+}
+
+// Register registers the params of the given modules.
+// It panics if a message type URL or a subspace is already owned by another module
+func (r *ParamsRegistry) Register(modules ...ParamsModule) {
+	for _, module := range modules {
+		registration := module.ParamsRegistration()
+
+		for _, typeURL := range registration.MsgTypeURLs {
+			if existing, ok := r.byMsgTypeURL[typeURL]; ok {
+				panic(fmt.Errorf("%s is already registered by module %s", typeURL, existing.Module))
+			}
+			r.byMsgTypeURL[typeURL] = registration
+		}
+
+		for _, subspace := range registration.Subspaces {
+			if existing, ok := r.bySubspace[subspace]; ok {
+				panic(fmt.Errorf("subspace %s is already registered by module %s", subspace, existing.Module))
+			}
+			r.bySubspace[subspace] = registration
+		}
+
+		r.registrations = append(r.registrations, registration)
+	}
+}
+
+// GetByMsgTypeURL returns the registration of the module owning the message with the given type URL, if any
+func (r *ParamsRegistry) GetByMsgTypeURL(typeURL string) (ParamsRegistration, bool) {
+	registration, ok := r.byMsgTypeURL[typeURL]
+	return registration, ok
+}
+
+// GetBySubspace returns the registration of the module owning the given legacy subspace, if any
+func (r *ParamsRegistry) GetBySubspace(subspace string) (ParamsRegistration, bool) {
+	registration, ok := r.bySubspace[subspace]
+	return registration, ok
+}
+
+// Registrations returns all the registrations, in the order in which they have been registered
+func (r *ParamsRegistry) Registrations() []ParamsRegistration {
+	return r.registrations
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	modulestypes "github.com/forbole/bdjuno/v4/modules/types"
+)
+
+// fakeParamsModule implements modulestypes.ParamsModule recording the heights at which it has been refreshed
+type fakeParamsModule struct {
+	name        string
+	msgTypeURLs []string
+	subspaces   []string
+	refreshed   []int64
+}
+
+func (m *fakeParamsModule) ParamsRegistration() modulestypes.ParamsRegistration {
+	return modulestypes.ParamsRegistration{
+		Module:      m.name,
+		MsgTypeURLs: m.msgTypeURLs,
+		Subspaces:   m.subspaces,
+		Refresh: func(height int64) error {
+			m.refreshed = append(m.refreshed, height)
+			return nil
+		},
+	}
+}
+
+func TestParamsRegistry_Lookups(t *testing.T) {
+	bank := &fakeParamsModule{
+		name:        "bank",
+		msgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgUpdateParams"},
+		subspaces:   []string{"bank"},
+	}
+	consensus := &fakeParamsModule{
+		name:        "consensus",
+		msgTypeURLs: []string{"/cosmos.consensus.v1.MsgUpdateParams"},
+		subspaces:   []string{"baseapp"},
+	}
+
+	registry := modulestypes.NewParamsRegistry()
+	registry.Register(bank, consensus)
+
+	// Lookup by message type URL
+	registration, found := registry.GetByMsgTypeURL("/cosmos.consensus.v1.MsgUpdateParams")
+	require.True(t, found)
+	require.Equal(t, "consensus", registration.Module)
+
+	require.NoError(t, registration.Refresh(10))
+	require.Equal(t, []int64{10}, consensus.refreshed)
+	require.Empty(t, bank.refreshed)
+
+	_, found = registry.GetByMsgTypeURL("/cosmos.gov.v1.MsgUpdateParams")
+	require.False(t, found)
+
+	// Lookup by legacy subspace
+	registration, found = registry.GetBySubspace("bank")
+	require.True(t, found)
+	require.Equal(t, "bank", registration.Module)
+
+	registration, found = registry.GetBySubspace("baseapp")
+	require.True(t, found)
+	require.Equal(t, "consensus", registration.Module)
+
+	_, found = registry.GetBySubspace("gov")
+	require.False(t, found)
+
+	// Registrations are returned in the order in which they have been registered
+	registrations := registry.Registrations()
+	require.Len(t, registrations, 2)
+	require.Equal(t, "bank", registrations[0].Module)
+	require.Equal(t, "consensus", registrations[1].Module)
+}
+
+func TestParamsRegistry_RegisterDuplicates(t *testing.T) {
+	registry := modulestypes.NewParamsRegistry()
+	registry.Register(&fakeParamsModule{
+		name:        "bank",
+		msgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgUpdateParams"},
+		subspaces:   []string{"bank"},
+	})
+
+	// The same message type URL can't be owned by two modules
+	require.Panics(t, func() {
+		registry.Register(&fakeParamsModule{
+			name:        "other",
+			msgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgUpdateParams"},
+		})
+	})
+
+	// The same subspace can't be owned by two modules
+	require.Panics(t, func() {
+		registry.Register(&fakeParamsModule{name: "other", subspaces: []string{"bank"}})
+	})
+}
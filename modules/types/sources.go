@@ -9,10 +9,17 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/forbole/juno/v5/node/remote"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramsproposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,11 +27,23 @@ import (
 
 	nodeconfig "github.com/forbole/juno/v5/node/config"
 
+	authsource "github.com/forbole/bdjuno/v4/modules/auth/source"
+	localauthsource "github.com/forbole/bdjuno/v4/modules/auth/source/local"
+	remoteauthsource "github.com/forbole/bdjuno/v4/modules/auth/source/remote"
+	authzsource "github.com/forbole/bdjuno/v4/modules/authz/source"
+	localauthzsource "github.com/forbole/bdjuno/v4/modules/authz/source/local"
+	remoteauthzsource "github.com/forbole/bdjuno/v4/modules/authz/source/remote"
 	banksource "github.com/forbole/bdjuno/v4/modules/bank/source"
 	localbanksource "github.com/forbole/bdjuno/v4/modules/bank/source/local"
 	remotebanksource "github.com/forbole/bdjuno/v4/modules/bank/source/remote"
+	consensussource "github.com/forbole/bdjuno/v4/modules/consensus/source"
+	localconsensussource "github.com/forbole/bdjuno/v4/modules/consensus/source/local"
+	remoteconsensussource "github.com/forbole/bdjuno/v4/modules/consensus/source/remote"
 	distrsource "github.com/forbole/bdjuno/v4/modules/distribution/source"
 	remotedistrsource "github.com/forbole/bdjuno/v4/modules/distribution/source/remote"
+	feegrantsource "github.com/forbole/bdjuno/v4/modules/feegrant/source"
+	localfeegrantsource "github.com/forbole/bdjuno/v4/modules/feegrant/source/local"
+	remotefeegrantsource "github.com/forbole/bdjuno/v4/modules/feegrant/source/remote"
 	govsource "github.com/forbole/bdjuno/v4/modules/gov/source"
 	localgovsource "github.com/forbole/bdjuno/v4/modules/gov/source/local"
 	remotegovsource "github.com/forbole/bdjuno/v4/modules/gov/source/remote"
@@ -40,18 +59,22 @@ import (
 )
 
 type Sources struct {
-	BankSource     banksource.Source
-	DistrSource    distrsource.Source
-	GovSource      govsource.Source
-	MintSource     mintsource.Source
-	SlashingSource slashingsource.Source
-	StakingSource  stakingsource.Source
+	AuthSource      authsource.Source
+	AuthzSource     authzsource.Source
+	BankSource      banksource.Source
+	ConsensusSource consensussource.Source
+	DistrSource     distrsource.Source
+	FeegrantSource  feegrantsource.Source
+	GovSource       govsource.Source
+	MintSource      mintsource.Source
+	SlashingSource  slashingsource.Source
+	StakingSource   stakingsource.Source
 }
 
 func BuildSources(nodeCfg nodeconfig.Config, encodingConfig *params.EncodingConfig) (*Sources, error) {
 	switch cfg := nodeCfg.Details.(type) {
 	case *remote.Details:
-		return buildRemoteSources(cfg)
+		return buildRemoteSources(cfg, encodingConfig)
 	case *local.Details:
 		return buildLocalSources(cfg, encodingConfig)
 
@@ -71,8 +94,12 @@ func buildLocalSources(cfg *local.Details, encodingConfig *params.EncodingConfig
 	)
 
 	sources := &Sources{
-		BankSource: localbanksource.NewSource(source, banktypes.QueryServer(app.BankKeeper)),
+		AuthSource:      localauthsource.NewSource(source, authtypes.QueryServer(app.AccountKeeper)),
+		AuthzSource:     localauthzsource.NewSource(source, app.AuthzKeeper),
+		BankSource:      localbanksource.NewSource(source, banktypes.QueryServer(app.BankKeeper)),
+		ConsensusSource: localconsensussource.NewSource(source, consensuskeeper.NewQuerier(app.ConsensusParamsKeeper)),
 		// DistrSource:    localdistrsource.NewSource(source, distrtypes.QueryServer(app.DistrKeeper)),
+		FeegrantSource: localfeegrantsource.NewSource(source, app.FeeGrantKeeper),
 		GovSource:      localgovsource.NewSource(source, govtypesv1.QueryServer(app.GovKeeper)),
 		MintSource:     localmintsource.NewSource(source, minttypes.QueryServer(app.MintKeeper)),
 		SlashingSource: localslashingsource.NewSource(source, slashingtypes.QueryServer(app.SlashingKeeper)),
@@ -103,16 +130,26 @@ func buildLocalSources(cfg *local.Details, encodingConfig *params.EncodingConfig
 	return sources, nil
 }
 
-func buildRemoteSources(cfg *remote.Details) (*Sources, error) {
+func buildRemoteSources(cfg *remote.Details, encodingConfig *params.EncodingConfig) (*Sources, error) {
 	source, err := remote.NewSource(cfg.GRPC)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating remote source: %s", err)
 	}
 
 	return &Sources{
-		BankSource:     remotebanksource.NewSource(source, banktypes.NewQueryClient(source.GrpcConn)),
+		AuthSource:  remoteauthsource.NewSource(source, authtypes.NewQueryClient(source.GrpcConn)),
+		AuthzSource: remoteauthzsource.NewSource(source, authztypes.NewQueryClient(source.GrpcConn)),
+		BankSource:  remotebanksource.NewSource(source, banktypes.NewQueryClient(source.GrpcConn)),
+		ConsensusSource: remoteconsensussource.NewFallbackSource(
+			remoteconsensussource.NewSource(source, consensustypes.NewQueryClient(source.GrpcConn)),
+			remoteconsensussource.NewLegacySource(source, paramsproposaltypes.NewQueryClient(source.GrpcConn)),
+		),
 		DistrSource:    remotedistrsource.NewSource(source, distrtypes.NewQueryClient(source.GrpcConn)),
-		GovSource:      remotegovsource.NewSource(source, govtypesv1.NewQueryClient(source.GrpcConn)),
+		FeegrantSource: remotefeegrantsource.NewSource(source, feegranttypes.NewQueryClient(source.GrpcConn)),
+		GovSource: remotegovsource.NewFallbackSource(
+			remotegovsource.NewSource(source, govtypesv1.NewQueryClient(source.GrpcConn)),
+			remotegovsource.NewV1Beta1Source(source, govtypesv1beta1.NewQueryClient(source.GrpcConn), encodingConfig.Codec),
+		),
 		MintSource:     remotemintsource.NewSource(source, minttypes.NewQueryClient(source.GrpcConn)),
 		SlashingSource: remoteslashingsource.NewSource(source, slashingtypes.NewQueryClient(source.GrpcConn)),
 		StakingSource:  remotestakingsource.NewSource(source, stakingtypes.NewQueryClient(source.GrpcConn)),
@@ -1,5 +1,16 @@
 package types
 
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
 // Account represents a chain account
 type Account struct {
 	Address string
@@ -11,3 +22,212 @@ func NewAccount(address string) Account {
 		Address: address,
 	}
 }
+
+// AccountDetails contains the data of an account as it is stored on chain
+type AccountDetails struct {
+	Address string
+	Type    string
+	PubKey  cryptotypes.PubKey
+
+	// AccountNumber and Sequence are nil when the account type is not registered inside the codec
+	AccountNumber *uint64
+	Sequence      *uint64
+
+	Height int64
+}
+
+// NewAccountDetails builds a new AccountDetails instance from the given account
+func NewAccountDetails(account authtypes.AccountI, height int64) AccountDetails {
+	accountNumber, sequence := account.GetAccountNumber(), account.GetSequence()
+	return AccountDetails{
+		Address:       account.GetAddress().String(),
+		Type:          proto.MessageName(account),
+		PubKey:        account.GetPubKey(),
+		AccountNumber: &accountNumber,
+		Sequence:      &sequence,
+		Height:        height,
+	}
+}
+
+// NewUnknownAccountDetails builds a new AccountDetails instance for an account whose type
+// is not registered inside the codec (eg. interchain accounts), so that only its type is known
+func NewUnknownAccountDetails(address string, typeURL string, height int64) AccountDetails {
+	return AccountDetails{
+		Address: address,
+		Type:    strings.TrimPrefix(typeURL, "/"),
+		Height:  height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ModuleAccount represents an account owned by a module
+type ModuleAccount struct {
+	Address     string
+	Name        string
+	Permissions []string
+	Height      int64
+}
+
+// NewModuleAccount builds a new ModuleAccount instance from the given account
+func NewModuleAccount(account authtypes.ModuleAccountI, height int64) ModuleAccount {
+	return ModuleAccount{
+		Address:     account.GetAddress().String(),
+		Name:        account.GetName(),
+		Permissions: account.GetPermissions(),
+		Height:      height,
+	}
+}
+
+// AccountLabel represents a human readable label associated to an account
+type AccountLabel struct {
+	Address  string
+	Label    string
+	Category string
+}
+
+// NewAccountLabel returns a new AccountLabel instance
+func NewAccountLabel(address string, label string, category string) AccountLabel {
+	return AccountLabel{
+		Address:  address,
+		Label:    label,
+		Category: category,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// MultisigAccount represents a legacy amino multisig account
+type MultisigAccount struct {
+	Address   string
+	Threshold uint64
+	Members   []cryptotypes.PubKey
+	Height    int64
+}
+
+// NewMultisigAccount builds a new MultisigAccount instance from the given multisig public key
+func NewMultisigAccount(pubKey *multisig.LegacyAminoPubKey, height int64) MultisigAccount {
+	return MultisigAccount{
+		Address:   sdk.AccAddress(pubKey.Address()).String(),
+		Threshold: uint64(pubKey.GetThreshold()),
+		Members:   pubKey.GetPubKeys(),
+		Height:    height,
+	}
+}
+
+// MultisigSigner represents a member of a multisig account that has signed a transaction
+type MultisigSigner struct {
+	TxHash          string
+	MultisigAddress string
+	MemberAddress   string
+	Height          int64
+}
+
+// NewMultisigSigner returns a new MultisigSigner instance
+func NewMultisigSigner(txHash string, multisigAddress string, memberAddress string, height int64) MultisigSigner {
+	return MultisigSigner{
+		TxHash:          txHash,
+		MultisigAddress: multisigAddress,
+		MemberAddress:   memberAddress,
+		Height:          height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AccountActivity represents an account being involved inside a message
+type AccountActivity struct {
+	Address     string
+	TxHash      string
+	MsgIndex    int
+	MessageType string
+	Height      int64
+	Timestamp   time.Time
+}
+
+// NewAccountActivity returns a new AccountActivity instance
+func NewAccountActivity(
+	address string, txHash string, msgIndex int, messageType string, height int64, timestamp time.Time,
+) AccountActivity {
+	return AccountActivity{
+		Address:     address,
+		TxHash:      txHash,
+		MsgIndex:    msgIndex,
+		MessageType: messageType,
+		Height:      height,
+		Timestamp:   timestamp,
+	}
+}
+
+// AccountFees represents the fees paid by an account inside a transaction
+type AccountFees struct {
+	Address string
+	TxHash  string
+	Amount  sdk.Coins
+	Height  int64
+}
+
+// NewAccountFees returns a new AccountFees instance
+func NewAccountFees(address string, txHash string, amount sdk.Coins, height int64) AccountFees {
+	return AccountFees{
+		Address: address,
+		TxHash:  txHash,
+		Amount:  amount,
+		Height:  height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AuthParams represents the parameters of the x/auth module
+type AuthParams struct {
+	authtypes.Params
+	Height int64
+}
+
+// NewAuthParams returns a new AuthParams instance
+func NewAuthParams(params authtypes.Params, height int64) AuthParams {
+	return AuthParams{
+		Params: params,
+		Height: height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// VestingStatus represents the amounts that have been vested and that are still locked
+// inside a vesting account at a given time
+type VestingStatus struct {
+	Address         string
+	Time            time.Time
+	OriginalVesting sdk.Coins
+	Vested          sdk.Coins
+	Locked          sdk.Coins
+}
+
+// NewVestingStatus returns a new VestingStatus instance
+func NewVestingStatus(
+	address string, time time.Time, originalVesting sdk.Coins, vested sdk.Coins, locked sdk.Coins,
+) VestingStatus {
+	return VestingStatus{
+		Address:         address,
+		Time:            time,
+		OriginalVesting: originalVesting,
+		Vested:          vested,
+		Locked:          locked,
+	}
+}
+
+// VestingUnlock represents the amount of tokens that are unlocked by all the vesting accounts during a day
+type VestingUnlock struct {
+	Date   time.Time
+	Amount sdk.Coins
+}
+
+// NewVestingUnlock returns a new VestingUnlock instance
+func NewVestingUnlock(date time.Time, amount sdk.Coins) VestingUnlock {
+	return VestingUnlock{
+		Date:   date,
+		Amount: amount,
+	}
+}
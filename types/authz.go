@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// AuthzGrant represents a grant given by a granter to a grantee using the x/authz module
+type AuthzGrant struct {
+	authz.Grant
+	Granter    string
+	Grantee    string
+	MsgTypeURL string
+	Height     int64
+}
+
+// NewAuthzGrant allows to build a new AuthzGrant instance
+func NewAuthzGrant(granter string, grantee string, msgTypeURL string, grant authz.Grant, height int64) AuthzGrant {
+	return AuthzGrant{
+		Grant:      grant,
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeURL: msgTypeURL,
+		Height:     height,
+	}
+}
+
+// AuthzGrantRemoval represents the removal of the grant given by a granter to a grantee for a message type
+type AuthzGrantRemoval struct {
+	Granter    string
+	Grantee    string
+	MsgTypeURL string
+	Height     int64
+}
+
+// NewAuthzGrantRemoval allows to build a new AuthzGrantRemoval instance
+func NewAuthzGrantRemoval(granter string, grantee string, msgTypeURL string, height int64) AuthzGrantRemoval {
+	return AuthzGrantRemoval{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeURL: msgTypeURL,
+		Height:     height,
+	}
+}
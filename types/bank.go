@@ -1,6 +1,12 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
 
 // AccountBalance represents the balance of an account at a given height
 type AccountBalance struct {
@@ -17,3 +23,98 @@ func NewAccountBalance(address string, balance sdk.Coins, height int64) AccountB
 		Height:  height,
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// BankParams represents the parameters of the x/bank module
+type BankParams struct {
+	banktypes.Params
+	Height int64
+}
+
+// NewBankParams returns a new BankParams instance
+func NewBankParams(params banktypes.Params, height int64) BankParams {
+	return BankParams{
+		Params: params,
+		Height: height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+const (
+	SupplyChangeTypeMint = "mint"
+	SupplyChangeTypeBurn = "burn"
+
+	// SupplyChangeOriginInflation identifies the coins minted by x/mint as block provisions
+	SupplyChangeOriginInflation = "inflation"
+	// SupplyChangeOriginSlashing identifies the coins burned from the staking pools when slashing a validator
+	SupplyChangeOriginSlashing = "slashing"
+	// SupplyChangeOriginGovDeposit identifies the proposal deposits burned by x/gov
+	SupplyChangeOriginGovDeposit = "gov_deposit"
+	// SupplyChangeOriginModule identifies the coins minted or burned by any other known module account
+	SupplyChangeOriginModule = "module"
+	// SupplyChangeOriginCustom identifies the coins minted or burned by any unknown account
+	SupplyChangeOriginCustom = "custom"
+)
+
+// SupplyChange represents an amount of a single denom that has been minted or burned
+type SupplyChange struct {
+	Type       string
+	Origin     string
+	Address    string
+	Denom      string
+	Amount     sdkmath.Int
+	TxHash     string
+	EventIndex int
+	Height     int64
+}
+
+// NewSupplyChange allows to build a new SupplyChange instance
+func NewSupplyChange(
+	changeType string, origin string, address string, denom string, amount sdkmath.Int,
+	txHash string, eventIndex int, height int64,
+) SupplyChange {
+	return SupplyChange{
+		Type:       changeType,
+		Origin:     origin,
+		Address:    address,
+		Denom:      denom,
+		Amount:     amount,
+		TxHash:     txHash,
+		EventIndex: eventIndex,
+		Height:     height,
+	}
+}
+
+// SupplyReconciliation represents the comparison between the supply delta of a denom during a day
+// and the amounts that have been minted and burned during the same day
+type SupplyReconciliation struct {
+	Date        time.Time
+	Denom       string
+	StartHeight int64
+	EndHeight   int64
+	SupplyDelta sdkmath.Int
+	Minted      sdkmath.Int
+	Burned      sdkmath.Int
+}
+
+// NewSupplyReconciliation allows to build a new SupplyReconciliation instance
+func NewSupplyReconciliation(
+	date time.Time, denom string, startHeight, endHeight int64, supplyDelta, minted, burned sdkmath.Int,
+) SupplyReconciliation {
+	return SupplyReconciliation{
+		Date:        date,
+		Denom:       denom,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		SupplyDelta: supplyDelta,
+		Minted:      minted,
+		Burned:      burned,
+	}
+}
+
+// Unexplained returns the part of the supply delta that is not explained by the minted and burned amounts
+func (r SupplyReconciliation) Unexplained() sdkmath.Int {
+	return r.SupplyDelta.Sub(r.Minted).Add(r.Burned)
+}
@@ -1,6 +1,13 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
 
 // Genesis contains the useful information about the genesis
 type Genesis struct {
@@ -49,3 +56,79 @@ func (c ConsensusEvent) Equal(other ConsensusEvent) bool {
 		c.Round == other.Round &&
 		c.Step == other.Step
 }
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// ConsensusParams represents the consensus params of the chain
+type ConsensusParams struct {
+	cmtproto.ConsensusParams
+	Height int64
+}
+
+// NewConsensusParams returns a new ConsensusParams instance
+func NewConsensusParams(params cmtproto.ConsensusParams, height int64) ConsensusParams {
+	return ConsensusParams{
+		ConsensusParams: params,
+		Height:          height,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------------------------
+
+// BlockGasUsage contains the amount of gas used and wanted by the transactions of a block
+type BlockGasUsage struct {
+	Height    int64
+	GasUsed   int64
+	GasWanted int64
+}
+
+// NewBlockGasUsage returns a new BlockGasUsage instance
+func NewBlockGasUsage(height int64, gasUsed, gasWanted int64) BlockGasUsage {
+	return BlockGasUsage{
+		Height:    height,
+		GasUsed:   gasUsed,
+		GasWanted: gasWanted,
+	}
+}
+
+// GasPrice contains the stats of the gas prices paid using a single fee denom
+type GasPrice struct {
+	Denom          string
+	MinGasPrice    sdk.Dec
+	MedianGasPrice sdk.Dec
+	P90GasPrice    sdk.Dec
+	TotalFees      sdkmath.Int
+	TxCount        int64
+}
+
+// NewGasPrice returns a new GasPrice instance
+func NewGasPrice(
+	denom string, minGasPrice, medianGasPrice, p90GasPrice sdk.Dec, totalFees sdkmath.Int, txCount int64,
+) GasPrice {
+	return GasPrice{
+		Denom:          denom,
+		MinGasPrice:    minGasPrice,
+		MedianGasPrice: medianGasPrice,
+		P90GasPrice:    p90GasPrice,
+		TotalFees:      totalFees,
+		TxCount:        txCount,
+	}
+}
+
+// SuggestedGasPrice contains the gas prices suggested for a fee denom, based on the ones recently paid
+type SuggestedGasPrice struct {
+	Denom   string
+	Low     sdk.Dec
+	Average sdk.Dec
+	High    sdk.Dec
+}
+
+// NewSuggestedGasPrice returns a new SuggestedGasPrice instance
+func NewSuggestedGasPrice(denom string, low, average, high sdk.Dec) SuggestedGasPrice {
+	return SuggestedGasPrice{
+		Denom:   denom,
+		Low:     low,
+		Average: average,
+		High:    high,
+	}
+}
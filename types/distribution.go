@@ -1,6 +1,9 @@
 package types
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -17,3 +20,179 @@ func NewDistributionParams(params distrtypes.Params, height int64) *Distribution
 		Height: height,
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// DelegatorRewardWithdrawal represents a single withdrawal of the rewards of a delegation
+type DelegatorRewardWithdrawal struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Amount           sdk.Coins
+	TxHash           string
+	MsgIndex         int
+	Height           int64
+}
+
+// NewDelegatorRewardWithdrawal returns a new DelegatorRewardWithdrawal instance
+func NewDelegatorRewardWithdrawal(
+	delegator string, validator string, amount sdk.Coins, txHash string, msgIndex int, height int64,
+) DelegatorRewardWithdrawal {
+	return DelegatorRewardWithdrawal{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+		TxHash:           txHash,
+		MsgIndex:         msgIndex,
+		Height:           height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatorCommissionWithdrawal represents a single withdrawal of the commission of a validator
+type ValidatorCommissionWithdrawal struct {
+	ValidatorAddress string
+	Amount           sdk.Coins
+	TxHash           string
+	MsgIndex         int
+	Height           int64
+}
+
+// NewValidatorCommissionWithdrawal returns a new ValidatorCommissionWithdrawal instance
+func NewValidatorCommissionWithdrawal(
+	validator string, amount sdk.Coins, txHash string, msgIndex int, height int64,
+) ValidatorCommissionWithdrawal {
+	return ValidatorCommissionWithdrawal{
+		ValidatorAddress: validator,
+		Amount:           amount,
+		TxHash:           txHash,
+		MsgIndex:         msgIndex,
+		Height:           height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// DelegatorWithdrawAddress represents the address to which the rewards of a delegator are sent
+type DelegatorWithdrawAddress struct {
+	DelegatorAddress string
+	WithdrawAddress  string
+	Height           int64
+}
+
+// NewDelegatorWithdrawAddress returns a new DelegatorWithdrawAddress instance
+func NewDelegatorWithdrawAddress(delegator string, withdrawAddress string, height int64) DelegatorWithdrawAddress {
+	return DelegatorWithdrawAddress{
+		DelegatorAddress: delegator,
+		WithdrawAddress:  withdrawAddress,
+		Height:           height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// CommunityPoolDeposit represents an amount sent to the community pool using MsgFundCommunityPool
+type CommunityPoolDeposit struct {
+	Depositor string
+	Amount    sdk.Coins
+	TxHash    string
+	MsgIndex  int
+	Height    int64
+}
+
+// NewCommunityPoolDeposit returns a new CommunityPoolDeposit instance
+func NewCommunityPoolDeposit(
+	depositor string, amount sdk.Coins, txHash string, msgIndex int, height int64,
+) CommunityPoolDeposit {
+	return CommunityPoolDeposit{
+		Depositor: depositor,
+		Amount:    amount,
+		TxHash:    txHash,
+		MsgIndex:  msgIndex,
+		Height:    height,
+	}
+}
+
+// CommunityPoolTax represents the amount of the fees collected in a block that has been sent to the community pool
+type CommunityPoolTax struct {
+	Amount sdk.DecCoins
+	Height int64
+}
+
+// NewCommunityPoolTax returns a new CommunityPoolTax instance
+func NewCommunityPoolTax(amount sdk.DecCoins, height int64) CommunityPoolTax {
+	return CommunityPoolTax{
+		Amount: amount,
+		Height: height,
+	}
+}
+
+// CommunityPoolSpend represents an amount spent from the community pool by a passed governance proposal
+type CommunityPoolSpend struct {
+	ProposalID uint64
+	MsgIndex   int
+	Recipient  string
+	Amount     sdk.Coins
+	Height     int64
+}
+
+// NewCommunityPoolSpend returns a new CommunityPoolSpend instance
+func NewCommunityPoolSpend(
+	proposalID uint64, msgIndex int, recipient string, amount sdk.Coins, height int64,
+) CommunityPoolSpend {
+	return CommunityPoolSpend{
+		ProposalID: proposalID,
+		MsgIndex:   msgIndex,
+		Recipient:  recipient,
+		Amount:     amount,
+		Height:     height,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// DelegatorRewardSnapshot represents the pending rewards of a delegation at a given height
+type DelegatorRewardSnapshot struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Amount           sdk.DecCoins
+	Height           int64
+	Timestamp        time.Time
+}
+
+// NewDelegatorRewardSnapshot returns a new DelegatorRewardSnapshot instance
+func NewDelegatorRewardSnapshot(
+	delegator string, validator string, amount sdk.DecCoins, height int64, timestamp time.Time,
+) DelegatorRewardSnapshot {
+	return DelegatorRewardSnapshot{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+		Height:           height,
+		Timestamp:        timestamp,
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// ValidatorRewards represents the outstanding rewards and the accumulated commission of a validator at a given height
+type ValidatorRewards struct {
+	ValidatorConsAddr  string
+	OutstandingRewards sdk.DecCoins
+	Commission         sdk.DecCoins
+	Height             int64
+	Timestamp          time.Time
+}
+
+// NewValidatorRewards returns a new ValidatorRewards instance
+func NewValidatorRewards(
+	validatorConsAddr string, outstandingRewards sdk.DecCoins, commission sdk.DecCoins, height int64, timestamp time.Time,
+) ValidatorRewards {
+	return ValidatorRewards{
+		ValidatorConsAddr:  validatorConsAddr,
+		OutstandingRewards: outstandingRewards,
+		Commission:         commission,
+		Height:             height,
+		Timestamp:          timestamp,
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+const (
+	EventSourceTx         = "tx"
+	EventSourceBeginBlock = "begin_block"
+	EventSourceEndBlock   = "end_block"
+)
+
+// EventAttribute represents a single key/value attribute of an event
+type EventAttribute struct {
+	Key   string
+	Value string
+}
+
+// NewEventAttribute returns a new EventAttribute instance
+func NewEventAttribute(key, value string) EventAttribute {
+	return EventAttribute{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// Event represents an event emitted either by a transaction or during the Begin/EndBlock of a block
+type Event struct {
+	Height int64
+
+	// Source is either EventSourceTx, EventSourceBeginBlock or EventSourceEndBlock
+	Source string
+
+	// TxHash and MsgIndex are only set for transaction events.
+	// MsgIndex is nil when the event has not been emitted by a specific message (eg. fees payment events)
+	TxHash   string
+	MsgIndex *uint32
+
+	// Index represents the position of the event among the ones having the same source
+	Index int
+
+	Type       string
+	Attributes []EventAttribute
+}
+
+// NewEvent returns a new Event instance
+func NewEvent(
+	height int64, source string, txHash string, msgIndex *uint32, index int, eventType string, attributes []EventAttribute,
+) Event {
+	return Event{
+		Height:     height,
+		Source:     source,
+		TxHash:     txHash,
+		MsgIndex:   msgIndex,
+		Index:      index,
+		Type:       eventType,
+		Attributes: attributes,
+	}
+}
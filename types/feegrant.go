@@ -1,6 +1,9 @@
 package types
 
-import feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+)
 
 // FeeGrant represents the x/feegrant module
 type FeeGrant struct {
@@ -30,3 +33,23 @@ func NewGrantRemoval(grantee string, granter string, height int64) GrantRemoval
 		height,
 	}
 }
+
+// FeeGrantUsage represents the usage of a fee grant allowance to pay the fees of a transaction
+type FeeGrantUsage struct {
+	TxHash  string
+	Granter string
+	Grantee string
+	Fee     sdk.Coins
+	Height  int64
+}
+
+// NewFeeGrantUsage allows to build a new FeeGrantUsage instance
+func NewFeeGrantUsage(txHash string, granter string, grantee string, fee sdk.Coins, height int64) FeeGrantUsage {
+	return FeeGrantUsage{
+		TxHash:  txHash,
+		Granter: granter,
+		Grantee: grantee,
+		Fee:     fee,
+		Height:  height,
+	}
+}
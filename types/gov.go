@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,6 +14,14 @@ import (
 
 const (
 	ProposalStatusInvalid = "PROPOSAL_STATUS_INVALID"
+
+	DepositOutcomeRefunded = "refunded"
+	DepositOutcomeBurned   = "burned"
+
+	TallyOutcomePassed   = "passed"
+	TallyOutcomeRejected = "rejected"
+	TallyOutcomeVetoed   = "vetoed"
+	TallyOutcomeNoQuorum = "no_quorum"
 )
 
 // GovParams contains the data of the x/gov module parameters
@@ -122,6 +132,29 @@ func NewDeposit(
 	}
 }
 
+// ProposalDepositOutcome contains the data about what happened to the deposit of a single depositor
+// once the proposal has left the deposit or voting period
+type ProposalDepositOutcome struct {
+	ProposalID uint64
+	Depositor  string
+	Amount     sdk.Coins
+	Outcome    string
+	Height     int64
+}
+
+// NewProposalDepositOutcome returns a new ProposalDepositOutcome instance
+func NewProposalDepositOutcome(
+	proposalID uint64, depositor string, amount sdk.Coins, outcome string, height int64,
+) ProposalDepositOutcome {
+	return ProposalDepositOutcome{
+		ProposalID: proposalID,
+		Depositor:  depositor,
+		Amount:     amount,
+		Outcome:    outcome,
+		Height:     height,
+	}
+}
+
 // -------------------------------------------------------------------------------------------------------------------
 
 // Vote contains the data of a single proposal vote
@@ -186,6 +219,137 @@ func NewTallyResult(
 
 // -------------------------------------------------------------------------------------------------------------------
 
+// ProposalTallyStatus contains the status of the tally of a proposal evaluated against the gov params
+type ProposalTallyStatus struct {
+	ProposalID       uint64
+	Turnout          sdk.Dec
+	YesRatio         sdk.Dec
+	VetoRatio        sdk.Dec
+	QuorumReached    bool
+	ProjectedOutcome string
+	Height           int64
+}
+
+// NewProposalTallyStatus returns a new ProposalTallyStatus instance
+func NewProposalTallyStatus(
+	proposalID uint64,
+	turnout sdk.Dec,
+	yesRatio sdk.Dec,
+	vetoRatio sdk.Dec,
+	quorumReached bool,
+	projectedOutcome string,
+	height int64,
+) ProposalTallyStatus {
+	return ProposalTallyStatus{
+		ProposalID:       proposalID,
+		Turnout:          turnout,
+		YesRatio:         yesRatio,
+		VetoRatio:        vetoRatio,
+		QuorumReached:    quorumReached,
+		ProjectedOutcome: projectedOutcome,
+		Height:           height,
+	}
+}
+
+// ComputeProposalTallyStatus evaluates the given tally against the given gov params and bonded tokens,
+// following the same rules the x/gov module applies when the voting period ends
+func ComputeProposalTallyStatus(
+	tally TallyResult, params *govtypesv1.Params, bondedTokens sdkmath.Int,
+) (ProposalTallyStatus, error) {
+	var votes [4]sdkmath.Int
+	for i, value := range []string{tally.Yes, tally.Abstain, tally.No, tally.NoWithVeto} {
+		amount, ok := sdkmath.NewIntFromString(value)
+		if !ok {
+			return ProposalTallyStatus{}, fmt.Errorf("invalid tally amount: %s", value)
+		}
+		votes[i] = amount
+	}
+	yes, abstain, no, noWithVeto := votes[0], votes[1], votes[2], votes[3]
+
+	quorum, err := sdk.NewDecFromStr(params.Quorum)
+	if err != nil {
+		return ProposalTallyStatus{}, fmt.Errorf("invalid quorum: %s", err)
+	}
+
+	threshold, err := sdk.NewDecFromStr(params.Threshold)
+	if err != nil {
+		return ProposalTallyStatus{}, fmt.Errorf("invalid threshold: %s", err)
+	}
+
+	vetoThreshold, err := sdk.NewDecFromStr(params.VetoThreshold)
+	if err != nil {
+		return ProposalTallyStatus{}, fmt.Errorf("invalid veto threshold: %s", err)
+	}
+
+	totalVotes := yes.Add(abstain).Add(no).Add(noWithVeto)
+	nonAbstainVotes := totalVotes.Sub(abstain)
+
+	turnout := sdk.ZeroDec()
+	if bondedTokens.IsPositive() {
+		turnout = sdk.NewDecFromInt(totalVotes).QuoInt(bondedTokens)
+	}
+
+	yesRatio := sdk.ZeroDec()
+	if nonAbstainVotes.IsPositive() {
+		yesRatio = sdk.NewDecFromInt(yes).QuoInt(nonAbstainVotes)
+	}
+
+	vetoRatio := sdk.ZeroDec()
+	if totalVotes.IsPositive() {
+		vetoRatio = sdk.NewDecFromInt(noWithVeto).QuoInt(totalVotes)
+	}
+
+	quorumReached := bondedTokens.IsPositive() && turnout.GTE(quorum)
+
+	var outcome string
+	switch {
+	case !quorumReached:
+		outcome = TallyOutcomeNoQuorum
+	case !nonAbstainVotes.IsPositive():
+		outcome = TallyOutcomeRejected
+	case vetoRatio.GT(vetoThreshold):
+		outcome = TallyOutcomeVetoed
+	case yesRatio.GT(threshold):
+		outcome = TallyOutcomePassed
+	default:
+		outcome = TallyOutcomeRejected
+	}
+
+	return NewProposalTallyStatus(
+		tally.ProposalID,
+		turnout,
+		yesRatio,
+		vetoRatio,
+		quorumReached,
+		outcome,
+		tally.Height,
+	), nil
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
+// ProposalExecutionResult contains the result of the execution of the messages of a passed proposal
+type ProposalExecutionResult struct {
+	ProposalID uint64
+	Success    bool
+	Height     int64
+
+	// Log is empty when the chain does not emit the execution log (eg. x/gov v0.47)
+	Log string
+}
+
+// NewProposalExecutionResult returns a new ProposalExecutionResult instance
+func NewProposalExecutionResult(proposalID uint64, success bool, log string, height int64) ProposalExecutionResult {
+	return ProposalExecutionResult{
+		ProposalID: proposalID,
+		Success:    success,
+		Log:        log,
+		Height:     height,
+	}
+}
+
+// -------------------------------------------------------------------------------------------------------------------
+
 // ProposalStakingPoolSnapshot contains the data about a single staking pool snapshot to be associated with a proposal
 type ProposalStakingPoolSnapshot struct {
 	ProposalID uint64
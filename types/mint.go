@@ -1,6 +1,10 @@
 package types
 
-import minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
 
 // MintParams represents the x/mint parameters
 type MintParams struct {
@@ -15,3 +19,27 @@ func NewMintParams(params minttypes.Params, height int64) *MintParams {
 		Height: height,
 	}
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// BlockProvision contains the data of the mint event emitted during the BeginBlock of a given height
+type BlockProvision struct {
+	BondedRatio      sdk.Dec
+	Inflation        sdk.Dec
+	AnnualProvisions sdk.Dec
+	Amount           sdkmath.Int
+	Height           int64
+}
+
+// NewBlockProvision allows to build a new BlockProvision instance
+func NewBlockProvision(
+	bondedRatio sdk.Dec, inflation sdk.Dec, annualProvisions sdk.Dec, amount sdkmath.Int, height int64,
+) BlockProvision {
+	return BlockProvision{
+		BondedRatio:      bondedRatio,
+		Inflation:        inflation,
+		AnnualProvisions: annualProvisions,
+		Amount:           amount,
+		Height:           height,
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+const (
+	SearchResultAccount     = "account"
+	SearchResultBlock       = "block"
+	SearchResultProposal    = "proposal"
+	SearchResultTransaction = "transaction"
+	SearchResultValidator   = "validator"
+)
+
+// SearchResult represents a single entity matching a search query
+type SearchResult struct {
+	// Type is one of the SearchResult* constants
+	Type string
+
+	// ID identifies the entity: an address, a height, a proposal id or a hash
+	ID string
+
+	// Title contains a human readable description of the entity, if any
+	Title string
+
+	// Rank tells how much the entity matches the search query.
+	// Exact matches have rank 1, and are always returned before the text matches whatever their rank
+	Rank float64
+}
+
+// NewSearchResult returns a new SearchResult instance
+func NewSearchResult(resultType string, id string, title string, rank float64) SearchResult {
+	return SearchResult{
+		Type:  resultType,
+		ID:    id,
+		Title: title,
+		Rank:  rank,
+	}
+}
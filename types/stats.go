@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StatsPeriod represents the length of the period covered by a chain stats rollup
+type StatsPeriod string
+
+const (
+	StatsPeriodHour StatsPeriod = "hour"
+	StatsPeriodDay  StatsPeriod = "day"
+)
+
+// Truncate returns the start of the period containing the given time, expressed in UTC
+func (p StatsPeriod) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch p {
+	case StatsPeriodHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, time.UTC)
+	case StatsPeriodDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		panic(fmt.Errorf("invalid stats period: %s", p))
+	}
+}
+
+// Next returns the start of the period following the one starting at the given time
+func (p StatsPeriod) Next(start time.Time) time.Time {
+	switch p {
+	case StatsPeriodHour:
+		return start.Add(time.Hour)
+	case StatsPeriodDay:
+		return start.AddDate(0, 0, 1)
+	default:
+		panic(fmt.Errorf("invalid stats period: %s", p))
+	}
+}
+
+// Starts returns the start of all the periods containing at least one instant between from and to (both included)
+func (p StatsPeriod) Starts(from, to time.Time) []time.Time {
+	var starts []time.Time
+	for start := p.Truncate(from); !start.After(to); start = p.Next(start) {
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// TransactionError contains the classification of the error that made a transaction fail
+type TransactionError struct {
+	TxHash    string
+	Height    int64
+	Codespace string
+	Code      uint32
+
+	// Error contains the description of the registered error having the codespace and code above
+	Error string
+
+	// MessageTypes contains the distinct types of the messages included inside the transaction
+	MessageTypes []string
+}
+
+// NewTransactionError returns a new TransactionError instance
+func NewTransactionError(
+	txHash string, height int64, codespace string, code uint32, description string, messageTypes []string,
+) TransactionError {
+	return TransactionError{
+		TxHash:       txHash,
+		Height:       height,
+		Codespace:    codespace,
+		Code:         code,
+		Error:        description,
+		MessageTypes: messageTypes,
+	}
+}
+
+// MessageType represents a message type registered inside the application interface registry
+type MessageType struct {
+	Type    string
+	Package string
+}
+
+// NewMessageType returns a new MessageType instance from the given message proto name
+func NewMessageType(msgType string) MessageType {
+	var pkg string
+	if index := strings.LastIndex(msgType, "."); index >= 0 {
+		pkg = msgType[:index]
+	}
+
+	return MessageType{
+		Type:    msgType,
+		Package: pkg,
+	}
+}